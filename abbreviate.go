@@ -0,0 +1,253 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// commonAbbreviations maps frequent identifier words to their conventional short forms
+var commonAbbreviations = map[string]string{
+	"account":        "acct",
+	"address":        "addr",
+	"administrator":  "admin",
+	"amount":         "amt",
+	"application":    "app",
+	"argument":       "arg",
+	"attribute":      "attr",
+	"authentication": "authn",
+	"authorization":  "authz",
+	"average":        "avg",
+	"buffer":         "buf",
+	"calculate":      "calc",
+	"category":       "cat",
+	"column":         "col",
+	"command":        "cmd",
+	"configuration":  "config",
+	"connection":     "conn",
+	"context":        "ctx",
+	"count":          "cnt",
+	"customer":       "cust",
+	"database":       "db",
+	"default":        "def",
+	"department":     "dept",
+	"description":    "desc",
+	"destination":    "dest",
+	"development":    "dev",
+	"directory":      "dir",
+	"document":       "doc",
+	"environment":    "env",
+	"error":          "err",
+	"expression":     "expr",
+	"function":       "func",
+	"history":        "hist",
+	"identifier":     "id",
+	"image":          "img",
+	"index":          "idx",
+	"information":    "info",
+	"initialize":     "init",
+	"length":         "len",
+	"library":        "lib",
+	"management":     "mgmt",
+	"manager":        "mgr",
+	"maximum":        "max",
+	"message":        "msg",
+	"minimum":        "min",
+	"number":         "num",
+	"object":         "obj",
+	"organization":   "org",
+	"package":        "pkg",
+	"parameter":      "param",
+	"password":       "pwd",
+	"position":       "pos",
+	"previous":       "prev",
+	"production":     "prod",
+	"quantity":       "qty",
+	"reference":      "ref",
+	"repository":     "repo",
+	"request":        "req",
+	"response":       "resp",
+	"sequence":       "seq",
+	"source":         "src",
+	"specification":  "spec",
+	"statistics":     "stats",
+	"string":         "str",
+	"system":         "sys",
+	"table":          "tbl",
+	"temporary":      "tmp",
+	"timestamp":      "ts",
+	"transaction":    "txn",
+	"user":           "usr",
+	"value":          "val",
+	"variable":       "var",
+	"version":        "ver",
+}
+
+// Abbreviate shortens an identifier to at most max bytes while keeping it
+// readable, e.g. "customer_transaction_history" becomes "cust_txn_hist".
+// Words are shortened one at a time, longest first, using progressively
+// stronger steps: well-known abbreviations, dropping interior vowels, and
+// finally truncation. Every word keeps at least its first letter and the
+// input's naming convention is preserved. As a uniqueness hint, a word is not
+// shortened to the same form as another word while the budget allows some
+// other cut, so "start_stop" at 5 becomes "s_stp" rather than "st_st".
+// Identifiers that already fit are returned unchanged, and a max of zero or
+// less returns "". With WithStopWordLang, stop words are dropped before
+// any word is shortened, as long as another word remains:
+//
+//	Abbreviate("number_of_items", 10, WithStopWordLang("en")) // num_items
+func Abbreviate(ident string, max int, opts ...Option) string {
+	if max <= 0 {
+		return ""
+	}
+	if len(ident) <= max {
+		return ident
+	}
+	config := newConfig(opts)
+
+	style := DetectCase(ident)
+	var words []string
+	for _, word := range emptyConfig.split(ident) {
+		if word != "" {
+			words = append(words, strings.ToLower(word))
+		}
+	}
+
+	join := func() string { return joinInStyle(words, style) }
+	// collides reports whether replacing word i with w makes it identical to
+	// another word it differs from
+	collides := func(i int, w string) bool {
+		for j, other := range words {
+			if j != i && other == w && other != words[i] {
+				return true
+			}
+		}
+		return false
+	}
+	if config.StopWordLang != "" && len(join()) > max {
+		words = dropStopWords(words, config.StopWordLang)
+	}
+	steps := []func(string) string{
+		func(w string) string {
+			if short, ok := commonAbbreviations[w]; ok {
+				return short
+			}
+			return w
+		},
+		dropVowels,
+	}
+
+	for _, step := range steps {
+		for _, i := range longestFirst(words) {
+			if len(join()) <= max {
+				return join()
+			}
+			if short := step(words[i]); !collides(i, short) {
+				words[i] = short
+			}
+		}
+	}
+
+	// Truncate the longest words one rune at a time until the result fits,
+	// preferring cuts that keep the words distinct
+	for len(join()) > max {
+		cut := -1
+		for _, i := range longestFirst(words) {
+			if utf8.RuneCountInString(words[i]) <= 1 {
+				continue
+			}
+			if !collides(i, dropLastRune(words[i])) {
+				cut = i
+				break
+			}
+			if cut < 0 {
+				cut = i
+			}
+		}
+		if cut < 0 {
+			break
+		}
+		words[cut] = dropLastRune(words[cut])
+	}
+
+	result := join()
+	if len(result) > max {
+		result = truncateBytes(result, max)
+	}
+	return result
+}
+
+// dropStopWords removes the stop words of lang from words unless they are
+// all stop words
+func dropStopWords(words []string, lang string) []string {
+	kept := slices.DeleteFunc(slices.Clone(words), func(w string) bool { return IsStopWord(w, lang) })
+	if len(kept) == 0 {
+		return words
+	}
+	return kept
+}
+
+// longestFirst returns the indices of words ordered by decreasing length,
+// earlier words first among equals
+func longestFirst(words []string) []int {
+	indices := make([]int, len(words))
+	for i := range indices {
+		indices[i] = i
+	}
+	slices.SortStableFunc(indices, func(a, b int) int {
+		return len(words[b]) - len(words[a])
+	})
+	return indices
+}
+
+// dropVowels removes vowels after the first letter: "customer" -> "cstmr"
+func dropVowels(word string) string {
+	first, size := utf8.DecodeRuneInString(word)
+	var result strings.Builder
+	result.WriteRune(first)
+	for _, r := range word[size:] {
+		if !strings.ContainsRune("aeiou", r) {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+// dropLastRune returns word without its last rune
+func dropLastRune(word string) string {
+	_, size := utf8.DecodeLastRuneInString(word)
+	return word[:len(word)-size]
+}
+
+// joinInStyle joins lowercase words following the given naming convention
+func joinInStyle(words []string, style CaseStyle) string {
+	switch style {
+	case StyleCamel:
+		return emptyConfig.camelCase(words)
+	case StylePascal:
+		return emptyConfig.pascalCase(words)
+	case StyleTrain:
+		return emptyConfig.trainCase(words)
+	case StyleKebab:
+		return strings.Join(words, "-")
+	case StyleScreamingSnake:
+		return strings.ToUpper(strings.Join(words, "_"))
+	case StyleFlat:
+		return strings.Join(words, "")
+	default:
+		return strings.Join(words, "_")
+	}
+}
+
+// truncateBytes cuts s to at most n bytes without splitting a rune
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
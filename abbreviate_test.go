@@ -0,0 +1,141 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestAbbreviate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		expected string
+	}{
+		{
+			name:     "known abbreviations",
+			input:    "customer_transaction_history",
+			max:      13,
+			expected: "cust_txn_hist",
+		},
+		{
+			name:     "stops as soon as it fits",
+			input:    "customer_transaction_history",
+			max:      20,
+			expected: "customer_txn_history",
+		},
+		{
+			name:     "already fits",
+			input:    "user_id",
+			max:      10,
+			expected: "user_id",
+		},
+		{
+			name:     "drops vowels",
+			input:    "shipping_carrier_details",
+			max:      18,
+			expected: "shppng_crrr_dtls",
+		},
+		{
+			name:     "truncates words",
+			input:    "shipping_carrier_details",
+			max:      10,
+			expected: "sh_crr_dtl",
+		},
+		{
+			name:     "keeps camel case",
+			input:    "customerTransactionHistory",
+			max:      16,
+			expected: "custTxnHistory",
+		},
+		{
+			name:     "keeps screaming snake",
+			input:    "MAXIMUM_CONNECTION_COUNT",
+			max:      16,
+			expected: "MAX_CONN_COUNT",
+		},
+		{
+			name:     "keeps kebab",
+			input:    "database-configuration",
+			max:      10,
+			expected: "db-config",
+		},
+		{
+			name:     "hard limit",
+			input:    "a_b_c_d_e",
+			max:      4,
+			expected: "a_b_",
+		},
+		{
+			name:     "keeps words distinct",
+			input:    "start_stop",
+			max:      5,
+			expected: "s_stp",
+		},
+		{
+			name:     "keeps words distinct after dropping vowels",
+			input:    "user_usher",
+			max:      5,
+			expected: "u_ush",
+		},
+		{
+			name:     "repeated words",
+			input:    "id_id_id",
+			max:      5,
+			expected: "i_i_i",
+		},
+		{
+			name:     "zero max",
+			input:    "customer_name",
+			max:      0,
+			expected: "",
+		},
+		{
+			name:     "negative max",
+			input:    "customer_name",
+			max:      -1,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Abbreviate(tt.input, tt.max)
+			if result != tt.expected {
+				t.Errorf("Abbreviate(%q, %d) = %q, want %q", tt.input, tt.max, result, tt.expected)
+			}
+			if len(result) > max(tt.max, 0) {
+				t.Errorf("Abbreviate(%q, %d) = %q exceeds max", tt.input, tt.max, result)
+			}
+		})
+	}
+}
+
+func TestAbbreviateStopWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		lang     string
+		expected string
+	}{
+		{name: "english", input: "number_of_items", max: 10, lang: "en", expected: "num_items"},
+		{name: "camel", input: "sizeOfTheBuffer", max: 10, lang: "en", expected: "sizeBuffer"},
+		{name: "german", input: "anzahl_der_benutzer", max: 15, lang: "de", expected: "anzahl_benutzer"},
+		{name: "only stop words kept", input: "of_the_and", max: 8, lang: "en", expected: "of_th_an"},
+		{name: "fits unchanged", input: "number_of_items", max: 20, lang: "en", expected: "number_of_items"},
+		{name: "unsupported language", input: "number_of_items", max: 10, lang: "xx", expected: "nm_of_itms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Abbreviate(tt.input, tt.max, sx.WithStopWordLang(tt.lang))
+			if result != tt.expected {
+				t.Errorf("Abbreviate(%q, %d, %q) = %q, want %q", tt.input, tt.max, tt.lang, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package sx
+
+import (
+	"strings"
+)
+
+// GoInitialisms is the list of initialisms golint expects to be written in a
+// consistent case in Go identifiers (userID, not userId). Pass it to
+// WithAcronyms, optionally extended with project-specific entries:
+//
+//	sx.PascalCase("user_id", sx.WithAcronyms(sx.GoInitialisms...)) // UserID
+//	sx.PascalCase("grpc_id", sx.WithAcronyms(sx.GoInitialisms...), sx.WithAcronyms("GRPC")) // GRPCID
+var GoInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP",
+	"HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA",
+	"SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID",
+	"URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// WithAcronyms registers acronyms that are rendered in their given spelling
+// whenever a word matches them case-insensitively, e.g. "Id" becomes "ID".
+// In camelCase a leading acronym is lowercased entirely ("idToken").
+func WithAcronyms(acronyms ...string) Option {
+	return func(c *Config) {
+		c.Acronyms = append(c.Acronyms, acronyms...)
+	}
+}
+
+// RegisterAcronyms adds acronyms to the package-wide registry, the acronyms of
+// the defaults set with SetDefaults, keeping the other defaults. Every
+// function taking Options then renders them like WithAcronyms does, so a
+// project registers its list once. A later SetDefaults call replaces the
+// registry along with the other defaults. It is safe for concurrent use.
+//
+// Example:
+//
+//	func init() {
+//		sx.RegisterAcronyms(sx.GoInitialisms...)
+//		sx.RegisterAcronyms("GRPC")
+//	}
+//
+//	sx.CamelCase("user_id") // userID
+//	sx.PascalCase("api_url") // APIURL
+//	sx.PascalCase("api_url", sx.WithAcronymStyle(sx.AcronymCapitalize)) // ApiUrl
+func RegisterAcronyms(acronyms ...string) {
+	for {
+		current := defaults.Load()
+		config := &Config{}
+		WithConfig(current)(config)
+		WithAcronyms(acronyms...)(config)
+		if defaults.CompareAndSwap(current, config) {
+			return
+		}
+	}
+}
+
+// AcronymStyle controls how acronyms are rendered by PascalCase, CamelCase and TrainCase
+type AcronymStyle int
+
+const (
+	// AcronymDefault renders registered acronyms in their registered spelling
+	// and leaves other words to WithNormalize
+	AcronymDefault AcronymStyle = iota
+	// AcronymPreserve keeps registered spellings and all-uppercase words as they are: XMLHttpRequest
+	AcronymPreserve
+	// AcronymCapitalize renders acronyms like ordinary words: XmlHttpRequest
+	AcronymCapitalize
+	// AcronymUpper renders acronyms fully uppercased: XMLHTTPRequest when HTTP is registered
+	AcronymUpper
+)
+
+// WithAcronymStyle sets how acronyms are rendered. With any style other than
+// AcronymDefault, words written entirely in uppercase ("XML" in
+// "XMLHttpRequest") are treated as acronyms as well as registered ones, and
+// the style applies regardless of WithNormalize. A leading acronym in
+// camelCase is always lowercased.
+func WithAcronymStyle(style AcronymStyle) Option {
+	return func(c *Config) {
+		c.AcronymStyle = style
+	}
+}
+
+// renderAcronym returns word rendered according to the acronym style if it is
+// recognized as an acronym
+func (c *Config) renderAcronym(word string) (string, bool) {
+	registered, ok := c.acronym(word)
+	if !ok {
+		if c.AcronymStyle == AcronymDefault || !isUpperWord(word) {
+			return "", false
+		}
+		registered = word
+	}
+
+	switch c.AcronymStyle {
+	case AcronymCapitalize:
+		return capitalizeWord(toLowerString(word)), true
+	case AcronymUpper:
+		return toUpperString(word), true
+	default:
+		return registered, true
+	}
+}
+
+// isUpperWord reports whether word contains uppercase letters and no lowercase ones
+func isUpperWord(word string) bool {
+	return strings.IndexFunc(word, isUpper) >= 0 && strings.IndexFunc(word, isLower) < 0
+}
+
+// acronym returns the registered spelling of word if it is a known acronym
+func (c *Config) acronym(word string) (string, bool) {
+	for _, a := range c.Acronyms {
+		if equalFold(a, word) {
+			return a, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,224 @@
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWithAcronyms(t *testing.T) {
+	goNames := sx.WithAcronyms(sx.GoInitialisms...)
+
+	tests := []struct {
+		name     string
+		input    string
+		function func(string, ...sx.CaseOption) string
+		options  []sx.CaseOption
+		expected string
+	}{
+		{
+			name:     "pascal trailing acronym",
+			input:    "user_id",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{goNames},
+			expected: "UserID",
+		},
+		{
+			name:     "pascal consecutive acronyms",
+			input:    "api_url",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{goNames},
+			expected: "APIURL",
+		},
+		{
+			name:     "camel trailing acronym",
+			input:    "user_id",
+			function: sx.CamelCase[string],
+			options:  []sx.CaseOption{goNames},
+			expected: "userID",
+		},
+		{
+			name:     "camel leading acronym",
+			input:    "HTTPServer",
+			function: sx.CamelCase[string],
+			options:  []sx.CaseOption{goNames},
+			expected: "httpServer",
+		},
+		{
+			name:     "camel from pascal",
+			input:    "ServeHttpUrl",
+			function: sx.CamelCase[string],
+			options:  []sx.CaseOption{goNames},
+			expected: "serveHTTPURL",
+		},
+		{
+			name:     "train",
+			input:    "json_api_key",
+			function: sx.TrainCase[string],
+			options:  []sx.CaseOption{goNames},
+			expected: "JSON-API-Key",
+		},
+		{
+			name:     "combined with normalize",
+			input:    "XML_PARSER_ID",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{goNames, sx.WithNormalize(true)},
+			expected: "XMLParserID",
+		},
+		{
+			name:     "custom spelling",
+			input:    "graphql_endpoint",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithAcronyms("GraphQL")},
+			expected: "GraphQLEndpoint",
+		},
+		{
+			name:     "user extended list",
+			input:    "grpc_id",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{goNames, sx.WithAcronyms("GRPC")},
+			expected: "GRPCID",
+		},
+		{
+			name:     "without acronyms",
+			input:    "user_id",
+			function: sx.PascalCase[string],
+			expected: "UserId",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.function(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWithAcronyms_Slice(t *testing.T) {
+	words := []string{"id", "token"}
+	if got := sx.CamelCase(words, sx.WithAcronyms(sx.GoInitialisms...)); got != "idToken" {
+		t.Errorf("CamelCase(%q) = %q, want %q", words, got, "idToken")
+	}
+	if got := sx.PascalCase(words, sx.WithAcronyms(sx.GoInitialisms...)); got != "IDToken" {
+		t.Errorf("PascalCase(%q) = %q, want %q", words, got, "IDToken")
+	}
+}
+
+func TestWithAcronymStyle(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		function func(string, ...sx.CaseOption) string
+		options  []sx.CaseOption
+		expected string
+	}{
+		{
+			name:     "preserve",
+			input:    "XMLHttpRequest",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithAcronymStyle(sx.AcronymPreserve), sx.WithNormalize(true)},
+			expected: "XMLHttpRequest",
+		},
+		{
+			name:     "capitalize",
+			input:    "XMLHttpRequest",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithAcronymStyle(sx.AcronymCapitalize)},
+			expected: "XmlHttpRequest",
+		},
+		{
+			name:     "capitalize registered",
+			input:    "user_id",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithAcronyms("ID"), sx.WithAcronymStyle(sx.AcronymCapitalize)},
+			expected: "UserId",
+		},
+		{
+			name:     "upper registered",
+			input:    "XMLHttpRequest",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithAcronyms(sx.GoInitialisms...), sx.WithAcronymStyle(sx.AcronymUpper)},
+			expected: "XMLHTTPRequest",
+		},
+		{
+			name:     "upper overrides registered spelling",
+			input:    "graphql_api",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithAcronyms("GraphQL"), sx.WithAcronymStyle(sx.AcronymUpper)},
+			expected: "GRAPHQLApi",
+		},
+		{
+			name:     "camel leading acronym lowercased",
+			input:    "XMLParser",
+			function: sx.CamelCase[string],
+			options:  []sx.CaseOption{sx.WithAcronymStyle(sx.AcronymPreserve)},
+			expected: "xmlParser",
+		},
+		{
+			name:     "camel capitalize",
+			input:    "parseHTMLDoc",
+			function: sx.CamelCase[string],
+			options:  []sx.CaseOption{sx.WithAcronymStyle(sx.AcronymCapitalize)},
+			expected: "parseHtmlDoc",
+		},
+		{
+			name:     "train upper",
+			input:    "content_md5",
+			function: sx.TrainCase[string],
+			options:  []sx.CaseOption{sx.WithAcronyms("MD5"), sx.WithAcronymStyle(sx.AcronymUpper)},
+			expected: "Content-MD5",
+		},
+		{
+			name:     "default leaves unregistered words to normalize",
+			input:    "XMLHttpRequest",
+			function: sx.PascalCase[string],
+			options:  []sx.CaseOption{sx.WithNormalize(true)},
+			expected: "XmlHttpRequest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.function(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterAcronyms(t *testing.T) {
+	sx.SetDefaults(sx.WithSeparators('_'))
+	t.Cleanup(func() { sx.SetDefaults() })
+	sx.RegisterAcronyms("ID", "URL")
+	sx.RegisterAcronyms("API")
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{name: "camel", result: sx.CamelCase("user_id"), expected: "userID"},
+		{name: "pascal", result: sx.PascalCase("api_url"), expected: "APIURL"},
+		{name: "capitalize style", result: sx.PascalCase("api_url", sx.WithAcronymStyle(sx.AcronymCapitalize)), expected: "ApiUrl"},
+		{name: "options extend registry", result: sx.PascalCase("grpc_id", sx.WithAcronyms("GRPC")), expected: "GRPCID"},
+		{name: "other defaults kept", result: sx.PascalCase("user-id_url"), expected: "User-idURL"},
+		{name: "snake", result: sx.SnakeCase("userID"), expected: "user_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+
+	sx.SetDefaults()
+	if result := sx.CamelCase("user_id"); result != "userId" {
+		t.Errorf("after SetDefaults CamelCase = %q, want %q", result, "userId")
+	}
+}
@@ -0,0 +1,94 @@
+package sx
+
+import "strings"
+
+// AffixStyle controls what splitting does with the prefixes and suffixes
+// registered with WithPrefixes and WithSuffixes
+type AffixStyle int
+
+const (
+	// AffixIsolate keeps a known affix as a word of its own: [tbl user account]
+	AffixIsolate AffixStyle = iota
+	// AffixStrip drops a known affix: [user account]
+	AffixStrip
+)
+
+// WithPrefixes registers namespace prefixes, such as "tbl_" or "m_", that
+// splitting recognizes at the start of the input, ignoring case. The longest
+// matching prefix is isolated as a single word or stripped, see
+// WithAffixStyle, so legacy database and C-style identifiers split cleanly.
+// An affix is only recognized when something follows it.
+//
+// Example:
+//
+//	SplitByCase("tbl_UserAccount", WithPrefixes("tbl_"))                              // [tbl User Account]
+//	SnakeCase("idx_UserEmail", WithPrefixes("idx_", "fk_"), WithAffixStyle(AffixStrip)) // user_email
+func WithPrefixes(prefixes ...string) Option {
+	return func(c *Config) {
+		c.Prefixes = append(c.Prefixes, prefixes...)
+	}
+}
+
+// WithSuffixes registers namespace suffixes, such as "_ptr" or "_impl", that
+// splitting recognizes at the end of the input, like WithPrefixes
+func WithSuffixes(suffixes ...string) Option {
+	return func(c *Config) {
+		c.Suffixes = append(c.Suffixes, suffixes...)
+	}
+}
+
+// WithAffixStyle sets whether known prefixes and suffixes are isolated as
+// words or stripped (default AffixIsolate)
+func WithAffixStyle(style AffixStyle) Option {
+	return func(c *Config) {
+		c.AffixStyle = style
+	}
+}
+
+// splitAffixes returns the known prefix and suffix of s, with the rest of s
+// between them. Separators between an affix and the rest belong to the
+// affix.
+func (c *Config) splitAffixes(s string) (prefix, rest, suffix string) {
+	rest = s
+	if p := longestAffix(c.Prefixes, rest, false); p > 0 {
+		p = len(rest) - len(strings.TrimLeftFunc(rest[p:], c.isSeparator))
+		prefix, rest = rest[:p], rest[p:]
+	}
+	if n := longestAffix(c.Suffixes, rest, true); n > 0 {
+		n = len(rest) - len(strings.TrimRightFunc(rest[:len(rest)-n], c.isSeparator))
+		rest, suffix = rest[:len(rest)-n], rest[len(rest)-n:]
+	}
+	return prefix, rest, suffix
+}
+
+// longestAffix returns the length of the longest of affixes that starts s,
+// or ends it if suffix is set, ignoring case. The whole of s is never an
+// affix.
+func longestAffix(affixes []string, s string, suffix bool) int {
+	longest := 0
+	for _, affix := range affixes {
+		if len(affix) <= longest || len(affix) >= len(s) {
+			continue
+		}
+		candidate := s[:len(affix)]
+		if suffix {
+			candidate = s[len(s)-len(affix):]
+		}
+		if equalFold(candidate, affix) {
+			longest = len(affix)
+		}
+	}
+	return longest
+}
+
+// appendAffix appends affix to dst as a word, without its separators, unless
+// affixes are stripped
+func (c *Config) appendAffix(dst []string, affix string) []string {
+	if c.AffixStyle == AffixStrip {
+		return dst
+	}
+	if word := strings.TrimFunc(affix, c.isSeparator); word != "" {
+		dst = append(dst, word)
+	}
+	return dst
+}
@@ -0,0 +1,70 @@
+package sx_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSplitByCaseAffixes(t *testing.T) {
+	affixes := []sx.Option{sx.WithPrefixes("tbl_", "fk_", "idx_", "m_"), sx.WithSuffixes("_ptr", "_impl")}
+	strip := append(slices.Clip(affixes), sx.WithAffixStyle(sx.AffixStrip))
+
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.Option
+		expected []string
+	}{
+		{name: "prefix isolated", input: "tbl_UserAccount", options: affixes, expected: []string{"tbl", "User", "Account"}},
+		{name: "prefix stripped", input: "tbl_UserAccount", options: strip, expected: []string{"User", "Account"}},
+		{name: "prefix ignores case", input: "TBL_USER_ACCOUNT", options: strip, expected: []string{"USER", "ACCOUNT"}},
+		{name: "prefix kept whole", input: "m_pBuffer", options: affixes, expected: []string{"m", "p", "Buffer"}},
+		{name: "suffix isolated", input: "nodeList_ptr", options: affixes, expected: []string{"node", "List", "ptr"}},
+		{name: "suffix stripped", input: "NodeList_IMPL", options: strip, expected: []string{"Node", "List"}},
+		{name: "prefix and suffix", input: "fk_order_ptr", options: strip, expected: []string{"order"}},
+		{name: "longest prefix wins", input: "idx_x_name", options: []sx.Option{sx.WithPrefixes("idx_", "idx_x_"), sx.WithAffixStyle(sx.AffixStrip)}, expected: []string{"name"}},
+		{name: "prefix without separator", input: "tblUsers", options: []sx.Option{sx.WithPrefixes("tbl"), sx.WithAffixStyle(sx.AffixStrip)}, expected: []string{"Users"}},
+		{name: "separators after prefix", input: "tbl__users", options: []sx.Option{sx.WithPrefixes("tbl")}, expected: []string{"tbl", "users"}},
+		{name: "whole input is no affix", input: "tbl_", options: strip, expected: []string{"tbl"}},
+		{name: "no match", input: "users_tbl", options: strip, expected: []string{"users", "tbl"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.SplitByCase(tt.input, tt.options...)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("SplitByCase(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertAffixes(t *testing.T) {
+	options := []sx.Option{sx.WithPrefixes("tbl_", "idx_"), sx.WithSuffixes("_impl")}
+	kebab := func(s string, opts ...sx.Option) string { return sx.DelimitedCase(s, "-", opts...) }
+
+	tests := []struct {
+		name     string
+		function func(string, ...sx.Option) string
+		input    string
+		options  []sx.Option
+		expected string
+	}{
+		{name: "pascal isolated", function: sx.PascalCase[string], input: "tbl_user_account", options: options, expected: "TblUserAccount"},
+		{name: "pascal stripped", function: sx.PascalCase[string], input: "tbl_user_account", options: append(slices.Clip(options), sx.WithAffixStyle(sx.AffixStrip)), expected: "UserAccount"},
+		{name: "snake stripped", function: sx.SnakeCase[string], input: "idx_UserEmail", options: append(slices.Clip(options), sx.WithAffixStyle(sx.AffixStrip)), expected: "user_email"},
+		{name: "camel suffix stripped", function: sx.CamelCase[string], input: "parser_impl", options: append(slices.Clip(options), sx.WithAffixStyle(sx.AffixStrip)), expected: "parser"},
+		{name: "kebab unchanged input", function: kebab, input: "tbl-users", options: append(slices.Clip(options), sx.WithAffixStyle(sx.AffixStrip)), expected: "tbl-users"},
+		{name: "kebab isolated", function: kebab, input: "tbl_users", options: options, expected: "tbl-users"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.function(tt.input, tt.options...); result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ArticleOption configures Article
+type ArticleOption func(*ArticleConfig)
+
+// ArticleConfig holds the configuration for Article
+type ArticleConfig struct {
+	// Prepend returns the article followed by the word instead of the article alone
+	Prepend bool
+}
+
+// defaultArticleConfig returns the default configuration for Article
+func defaultArticleConfig() *ArticleConfig {
+	return &ArticleConfig{}
+}
+
+// WithPrepend makes Article return the word with its article, such as "an hour"
+func WithPrepend() ArticleOption {
+	return func(c *ArticleConfig) {
+		c.Prepend = true
+	}
+}
+
+// articleExceptions lists word prefixes whose article is not predicted by
+// their first letter. The longest matching prefix wins, so more specific
+// entries can override broader ones.
+var articleExceptions = map[string]string{
+	// Silent h
+	"heir": "an", "honest": "an", "honor": "an", "honour": "an", "hour": "an",
+
+	// Vowels pronounced as consonants
+	"eu": "a", "ewe": "a", "once": "a", "one": "a", "oner": "an",
+	"ubiq": "a", "ufo": "a", "uganda": "a", "ukulele": "a", "ukrain": "a",
+	"unanim": "a", "uni": "a", "unid": "an", "unim": "an", "unin": "an",
+	"ura": "a", "ure": "a", "uri": "a", "uro": "a",
+	"usa": "a", "use": "a", "usu": "a", "uten": "a", "uti": "a", "uto": "a",
+}
+
+// articleTrie indexes articleExceptions for longest-prefix lookups
+var articleTrie = sync.OnceValue(func() *Trie[string] {
+	t := NewFoldTrie[string]()
+	for prefix, article := range articleExceptions {
+		t.Insert(prefix, article)
+	}
+	return t
+})
+
+// Article returns the English indefinite article, "a" or "an", for the
+// first word of s. The choice follows pronunciation rather than spelling:
+// exception lists cover silent h ("an hour") and vowels sounding like
+// consonants ("a user"), all-caps initialisms are read letter by letter
+// ("an FBI agent", "a URL"), and numbers are read aloud ("an 8", "an 11").
+//
+// Example:
+//
+//	Article("hour")                // "an"
+//	Article("user", WithPrepend()) // "a user"
+func Article(s string, opts ...ArticleOption) string {
+	config := defaultArticleConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	article := articleFor(s)
+	if config.Prepend {
+		return article + " " + s
+	}
+	return article
+}
+
+// articleFor picks the article for the first word of s
+func articleFor(s string) string {
+	word, _, _ := strings.Cut(strings.TrimSpace(s), " ")
+	word = strings.TrimLeftFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if word == "" {
+		return "a"
+	}
+
+	if word[0] >= '0' && word[0] <= '9' {
+		return numberArticle(word)
+	}
+
+	if isInitialism(word) {
+		// Letters whose names start with a vowel sound: "eff", "aitch", "ell", ...
+		if strings.ContainsRune("AEFHILMNORSX", rune(word[0])) {
+			return "an"
+		}
+		return "a"
+	}
+
+	if _, article, ok := articleTrie().LongestPrefix(word); ok {
+		return article
+	}
+	if strings.ContainsRune("aeiouAEIOU", rune(word[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// isInitialism reports whether word looks like an all-caps abbreviation that
+// is spelled out letter by letter: two or more uppercase ASCII letters that
+// either have no vowels or are at most three letters long
+func isInitialism(word string) bool {
+	letters := strings.TrimRightFunc(word, func(r rune) bool { return !unicode.IsLetter(r) })
+	if len(letters) < 2 {
+		return false
+	}
+	for i := 0; i < len(letters); i++ {
+		if letters[i] < 'A' || letters[i] > 'Z' {
+			return false
+		}
+	}
+	return len(letters) <= 3 || !strings.ContainsAny(letters, "AEIOU")
+}
+
+// numberArticle picks the article for a word starting with a digit, based on
+// how the number is read: eight, eighty, eleven and eighteen (and their
+// thousands) take "an"
+func numberArticle(word string) string {
+	var digits strings.Builder
+	for _, r := range word {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		} else if r != ',' && r != '_' {
+			break
+		}
+	}
+
+	d := digits.String()
+	if d[0] == '8' {
+		return "an"
+	}
+	if len(d)%3 == 2 && (d[:2] == "11" || d[:2] == "18") {
+		return "an"
+	}
+	return "a"
+}
@@ -0,0 +1,69 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestArticle(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "user", expected: "a"},
+		{input: "apple", expected: "an"},
+		{input: "hour", expected: "an"},
+		{input: "Honest mistake", expected: "an"},
+		{input: "house", expected: "a"},
+		{input: "university", expected: "a"},
+		{input: "unimportant detail", expected: "an"},
+		{input: "umbrella", expected: "an"},
+		{input: "European", expected: "a"},
+		{input: "one-time password", expected: "a"},
+		{input: "onerous task", expected: "an"},
+		{input: "FBI agent", expected: "an"},
+		{input: "URL", expected: "a"},
+		{input: "HTML page", expected: "an"},
+		{input: "SQL query", expected: "an"},
+		{input: "NASA engineer", expected: "a"},
+		{input: "8", expected: "an"},
+		{input: "80-page report", expected: "an"},
+		{input: "11", expected: "an"},
+		{input: "18,000", expected: "an"},
+		{input: "110", expected: "a"},
+		{input: "1", expected: "a"},
+		{input: "\"apple\"", expected: "an"},
+		{input: "", expected: "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := sx.Article(tt.input)
+			if result != tt.expected {
+				t.Errorf("Article(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestArticle_WithPrepend(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "hour", expected: "an hour"},
+		{input: "user", expected: "a user"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := sx.Article(tt.input, sx.WithPrepend())
+			if result != tt.expected {
+				t.Errorf("Article(%q, WithPrepend()) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package sx
+
+import "strings"
+
+// Match is a substring found in a larger text. Value is always s[Start:End].
+type Match struct {
+	Value string
+	Start int
+	End   int
+}
+
+// balanceQuotes are the quote characters whose content is skipped when
+// matching delimiters
+const balanceQuotes = "\"'`"
+
+// skipQuoted returns the offset just past the quoted section starting at
+// s[i], honoring backslash escapes, or len(s) with terminated false when the
+// quote is not closed. It returns i when s[i] is not a quote, is one of the
+// delimiters, or is an apostrophe within a word as in "it's".
+func skipQuoted(s string, i int, delimiters ...string) (end int, terminated bool) {
+	q := s[i]
+	if strings.IndexByte(balanceQuotes, q) < 0 || q == '\'' && i > 0 && isASCIIAlnum(s[i-1]) {
+		return i, true
+	}
+	for _, d := range delimiters {
+		if strings.IndexByte(d, q) >= 0 {
+			return i, true
+		}
+	}
+
+	for j := i + 1; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case q:
+			return j + 1, true
+		}
+	}
+	return len(s), false
+}
+
+// balancedEnd returns the offset of the close delimiter matching the open
+// delimiter at s[i], or -1 when it is not closed
+func balancedEnd(s string, i int, open, close string) int {
+	depth := 0
+	for i < len(s) {
+		if j, _ := skipQuoted(s, i, open, close); j != i {
+			i = j
+			continue
+		}
+		switch {
+		case strings.HasPrefix(s[i:], close) && depth > 0:
+			depth--
+			if depth == 0 {
+				return i
+			}
+			i += len(close)
+		case strings.HasPrefix(s[i:], open):
+			depth++
+			i += len(open)
+		default:
+			i++
+		}
+	}
+	return -1
+}
+
+// nextOpen returns the offset of the first open delimiter at or after s[i]
+// outside quotes, or -1
+func nextOpen(s string, i int, open, close string) int {
+	for i < len(s) {
+		if j, _ := skipQuoted(s, i, open, close); j != i {
+			i = j
+			continue
+		}
+		if strings.HasPrefix(s[i:], open) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// ExtractBalanced returns the content between the first open delimiter that
+// is closed and its matching close delimiter, honoring nesting. Delimiters
+// inside single, double or backtick quoted sections are ignored. ok is false
+// when s has no balanced group.
+//
+// Example:
+//
+//	ExtractBalanced("f(g(x), y)", "(", ")")      // "g(x), y", true
+//	ExtractBalanced(`f(")", y)`, "(", ")")       // `")", y`, true
+//	ExtractBalanced("{{ a {{b}} }}", "{{", "}}") // " a {{b}} ", true
+func ExtractBalanced(s string, open, close string) (string, bool) {
+	matches := extractBalanced(s, open, close, 1)
+	if len(matches) == 0 {
+		return "", false
+	}
+	return matches[0].Value, true
+}
+
+// ExtractAllBalanced returns the content of every top-level balanced group
+// in s, with its position. An open delimiter that is never closed is
+// skipped, so groups after it or nested in it are still found.
+//
+// Example:
+//
+//	ExtractAllBalanced("a(1) b(2(3))", "(", ")") // "1", "2(3)"
+func ExtractAllBalanced(s string, open, close string) []Match {
+	return extractBalanced(s, open, close, -1)
+}
+
+// extractBalanced returns up to n balanced groups, all of them when n < 0
+func extractBalanced(s string, open, close string, n int) []Match {
+	if open == "" || close == "" {
+		return nil
+	}
+
+	var matches []Match
+	for i := 0; n < 0 || len(matches) < n; {
+		start := nextOpen(s, i, open, close)
+		if start < 0 {
+			break
+		}
+		end := balancedEnd(s, start, open, close)
+		if end < 0 {
+			i = start + len(open)
+			continue
+		}
+		content := start + len(open)
+		matches = append(matches, Match{Value: s[content:end], Start: content, End: end})
+		i = end + len(close)
+	}
+	return matches
+}
+
+// Pair is an open and close delimiter, e.g. Pair{"(", ")"}
+type Pair struct {
+	Open, Close string
+}
+
+// defaultPairs are the delimiters CheckBalanced uses when none are given
+var defaultPairs = []Pair{{"(", ")"}, {"[", "]"}, {"{", "}"}}
+
+// CheckBalanced reports whether the delimiters of s are balanced and
+// properly nested. Without pairs, parentheses, brackets and braces are
+// checked. Delimiters inside single, double or backtick quoted sections are
+// ignored, and an unterminated quote is itself unbalanced. When s is not
+// balanced, pos is the byte offset of the first offending delimiter: a close
+// delimiter without a matching open one, or the earliest open delimiter left
+// unclosed. Otherwise pos is -1.
+//
+// Example:
+//
+//	CheckBalanced("f(a[1], {b})")           // true, -1
+//	CheckBalanced("f(a[1)]")                // false, 5
+//	CheckBalanced(`f(")"`)                  // false, 1
+//	CheckBalanced("{{x}", Pair{"{{", "}}"}) // false, 0
+func CheckBalanced(s string, pairs ...Pair) (ok bool, pos int) {
+	if len(pairs) == 0 {
+		pairs = defaultPairs
+	}
+	delimiters := make([]string, 0, 2*len(pairs))
+	for _, p := range pairs {
+		delimiters = append(delimiters, p.Open, p.Close)
+	}
+
+	type open struct {
+		pair   Pair
+		offset int
+	}
+	var stack []open
+	for i := 0; i < len(s); {
+		if j, terminated := skipQuoted(s, i, delimiters...); j != i {
+			if !terminated {
+				return false, i
+			}
+			i = j
+			continue
+		}
+
+		if len(stack) > 0 {
+			if top := stack[len(stack)-1].pair; strings.HasPrefix(s[i:], top.Close) {
+				stack = stack[:len(stack)-1]
+				i += len(top.Close)
+				continue
+			}
+		}
+		if p, ok := longestDelimiter(s[i:], pairs, func(p Pair) string { return p.Open }); ok {
+			stack = append(stack, open{pair: p, offset: i})
+			i += len(p.Open)
+			continue
+		}
+		if _, ok := longestDelimiter(s[i:], pairs, func(p Pair) string { return p.Close }); ok {
+			return false, i
+		}
+		i++
+	}
+
+	if len(stack) > 0 {
+		return false, stack[0].offset
+	}
+	return true, -1
+}
+
+// longestDelimiter returns the pair whose delimiter, selected by field, is
+// the longest prefix of s
+func longestDelimiter(s string, pairs []Pair, field func(Pair) string) (Pair, bool) {
+	var best Pair
+	found := false
+	for _, p := range pairs {
+		if d := field(p); d != "" && (!found || len(d) > len(field(best))) && strings.HasPrefix(s, d) {
+			best, found = p, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,114 @@
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestExtractBalanced(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		open, close string
+		expected    string
+		ok          bool
+	}{
+		{name: "nested", input: "f(g(x), y)", open: "(", close: ")", expected: "g(x), y", ok: true},
+		{name: "first group", input: "a(1) b(2)", open: "(", close: ")", expected: "1", ok: true},
+		{name: "quoted close", input: `f(")", y)`, open: "(", close: ")", expected: `")", y`, ok: true},
+		{name: "single quoted", input: `f(')', y)`, open: "(", close: ")", expected: `')', y`, ok: true},
+		{name: "escaped quote", input: `f("\")", y)`, open: "(", close: ")", expected: `"\")", y`, ok: true},
+		{name: "quoted open skipped", input: `"(" (x)`, open: "(", close: ")", expected: "x", ok: true},
+		{name: "apostrophe", input: "it's f(x)", open: "(", close: ")", expected: "x", ok: true},
+		{name: "multi-rune delimiters", input: "{{ a {{b}} }}", open: "{{", close: "}}", expected: " a {{b}} ", ok: true},
+		{name: "same delimiters", input: "a |b| c", open: "|", close: "|", expected: "b", ok: true},
+		{name: "empty group", input: "f()", open: "(", close: ")", expected: "", ok: true},
+		{name: "unbalanced", input: "f(g(x)", open: "(", close: ")", expected: "x", ok: true},
+		{name: "unclosed", input: "f(x", open: "(", close: ")", expected: "", ok: false},
+		{name: "no delimiters", input: "abc", open: "(", close: ")", expected: "", ok: false},
+		{name: "empty delimiter", input: "abc", open: "", close: ")", expected: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := sx.ExtractBalanced(tt.input, tt.open, tt.close)
+			if result != tt.expected || ok != tt.ok {
+				t.Errorf("ExtractBalanced(%q, %q, %q) = %q, %v, want %q, %v", tt.input, tt.open, tt.close, result, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestExtractAllBalanced(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		open, close string
+		expected    []sx.Match
+	}{
+		{
+			name:  "top-level groups",
+			input: "a(1) b(2(3))",
+			open:  "(", close: ")",
+			expected: []sx.Match{{Value: "1", Start: 2, End: 3}, {Value: "2(3)", Start: 7, End: 11}},
+		},
+		{
+			name:  "unclosed open skipped",
+			input: "[a [b] [c]",
+			open:  "[", close: "]",
+			expected: []sx.Match{{Value: "b", Start: 4, End: 5}, {Value: "c", Start: 8, End: 9}},
+		},
+		{
+			name:  "stray close ignored",
+			input: "} {x}",
+			open:  "{", close: "}",
+			expected: []sx.Match{{Value: "x", Start: 3, End: 4}},
+		},
+		{name: "none", input: "abc", open: "(", close: ")", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.ExtractAllBalanced(tt.input, tt.open, tt.close)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ExtractAllBalanced(%q, %q, %q) = %v, want %v", tt.input, tt.open, tt.close, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckBalanced(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pairs []sx.Pair
+		ok    bool
+		pos   int
+	}{
+		{name: "balanced", input: "f(a[1], {b})", ok: true, pos: -1},
+		{name: "empty", input: "", ok: true, pos: -1},
+		{name: "mismatched close", input: "f(a[1)]", ok: false, pos: 5},
+		{name: "stray close", input: "a)", ok: false, pos: 1},
+		{name: "unclosed", input: "(a [b", ok: false, pos: 0},
+		{name: "quoted delimiters", input: `f(")", '[')`, ok: true, pos: -1},
+		{name: "escaped quote", input: `f("\")")`, ok: true, pos: -1},
+		{name: "unterminated quote", input: `f(")`, ok: false, pos: 2},
+		{name: "escape at end", input: `"a\`, ok: false, pos: 0},
+		{name: "apostrophe", input: "(it's)", ok: true, pos: -1},
+		{name: "custom pairs", input: "{{a}} {b", pairs: []sx.Pair{{Open: "{{", Close: "}}"}}, ok: true, pos: -1},
+		{name: "custom unclosed", input: "{{x}", pairs: []sx.Pair{{Open: "{{", Close: "}}"}}, ok: false, pos: 0},
+		{name: "same delimiters", input: "|a| |b", pairs: []sx.Pair{{Open: "|", Close: "|"}}, ok: false, pos: 4},
+		{name: "keywords", input: "begin x begin y end end", pairs: []sx.Pair{{Open: "begin", Close: "end"}}, ok: true, pos: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, pos := sx.CheckBalanced(tt.input, tt.pairs...)
+			if ok != tt.ok || pos != tt.pos {
+				t.Errorf("CheckBalanced(%q) = %v, %d, want %v, %d", tt.input, ok, pos, tt.ok, tt.pos)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// CenterBlock centers a multi-line block within width display cells. The block
+// is shifted as a unit, so lines keep their alignment relative to each other.
+// Blocks wider than width are returned unchanged.
+func CenterBlock(s string, width int) string {
+	lines := strings.Split(s, "\n")
+
+	blockWidth := 0
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+		blockWidth = max(blockWidth, DisplayWidth(lines[i]))
+	}
+
+	indent := (width - blockWidth) / 2
+	if indent <= 0 {
+		return strings.Join(lines, "\n")
+	}
+
+	pad := strings.Repeat(" ", indent)
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = pad + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Justify stretches each line of s to exactly width display cells by
+// distributing extra spaces between words. The last line of every paragraph,
+// lines with a single word and lines already wider than width are left as is.
+func Justify(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lastInParagraph := i == len(lines)-1 || strings.TrimSpace(lines[i+1]) == ""
+		if lastInParagraph {
+			continue
+		}
+		lines[i] = justifyLine(line, width)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// justifyLine pads the gaps between the words of line so it spans width cells
+func justifyLine(line string, width int) string {
+	words := strings.Fields(line)
+	if len(words) < 2 {
+		return line
+	}
+
+	used := 0
+	for _, word := range words {
+		used += DisplayWidth(word)
+	}
+
+	gaps := len(words) - 1
+	spaces := width - used
+	if spaces < gaps {
+		return line
+	}
+
+	var result strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			// Leftmost gaps receive the remainder so the result is deterministic
+			n := spaces / gaps
+			if i <= spaces%gaps {
+				n++
+			}
+			result.WriteString(strings.Repeat(" ", n))
+		}
+		result.WriteString(word)
+	}
+
+	return result.String()
+}
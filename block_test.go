@@ -0,0 +1,107 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestCenterBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			name:     "single line",
+			input:    "hello",
+			width:    11,
+			expected: "   hello",
+		},
+		{
+			name:     "block keeps relative alignment",
+			input:    "Usage:\n  sx [command]",
+			width:    20,
+			expected: "   Usage:\n     sx [command]",
+		},
+		{
+			name:     "empty lines stay empty",
+			input:    "ab\n\ncd",
+			width:    6,
+			expected: "  ab\n\n  cd",
+		},
+		{
+			name:     "wide characters",
+			input:    "日本",
+			width:    8,
+			expected: "  日本",
+		},
+		{
+			name:     "wider than width",
+			input:    "too wide",
+			width:    4,
+			expected: "too wide",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.CenterBlock(tt.input, tt.width)
+			if result != tt.expected {
+				t.Errorf("CenterBlock(%q, %d) = %q, want %q", tt.input, tt.width, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJustify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			name:     "paragraph",
+			input:    "the quick brown\nfox jumps over\nthe lazy dog",
+			width:    16,
+			expected: "the  quick brown\nfox  jumps  over\nthe lazy dog",
+		},
+		{
+			name:     "paragraph breaks",
+			input:    "a b c\nend\n\nx y\nlast",
+			width:    7,
+			expected: "a  b  c\nend\n\nx     y\nlast",
+		},
+		{
+			name:     "single word",
+			input:    "word\nnext",
+			width:    10,
+			expected: "word\nnext",
+		},
+		{
+			name:     "too long",
+			input:    "already too long\nx",
+			width:    5,
+			expected: "already too long\nx",
+		},
+		{
+			name:     "wide characters",
+			input:    "日本 語\nx",
+			width:    8,
+			expected: "日本  語\nx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Justify(tt.input, tt.width)
+			if result != tt.expected {
+				t.Errorf("Justify(%q, %d) = %q, want %q", tt.input, tt.width, result, tt.expected)
+			}
+		})
+	}
+}
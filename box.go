@@ -0,0 +1,87 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// BoxStyle describes the border characters, padding and title used by Box
+type BoxStyle struct {
+	TopLeft     string
+	TopRight    string
+	BottomLeft  string
+	BottomRight string
+	Horizontal  string
+	Vertical    string
+	// Padding is the number of spaces between the border and the text
+	Padding int
+	// Title is rendered inside the top border when not empty
+	Title string
+}
+
+// Predefined box styles
+var (
+	BoxASCII   = BoxStyle{TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+", Horizontal: "-", Vertical: "|", Padding: 1}
+	BoxSingle  = BoxStyle{TopLeft: "┌", TopRight: "┐", BottomLeft: "└", BottomRight: "┘", Horizontal: "─", Vertical: "│", Padding: 1}
+	BoxDouble  = BoxStyle{TopLeft: "╔", TopRight: "╗", BottomLeft: "╚", BottomRight: "╝", Horizontal: "═", Vertical: "║", Padding: 1}
+	BoxRounded = BoxStyle{TopLeft: "╭", TopRight: "╮", BottomLeft: "╰", BottomRight: "╯", Horizontal: "─", Vertical: "│", Padding: 1}
+	BoxHeavy   = BoxStyle{TopLeft: "┏", TopRight: "┓", BottomLeft: "┗", BottomRight: "┛", Horizontal: "━", Vertical: "┃", Padding: 1}
+)
+
+// WithTitle returns a copy of the style with the given title
+func (b BoxStyle) WithTitle(title string) BoxStyle {
+	b.Title = title
+	return b
+}
+
+// WithPadding returns a copy of the style with the given padding
+func (b BoxStyle) WithPadding(padding int) BoxStyle {
+	b.Padding = padding
+	return b
+}
+
+// Box draws a border around s. Each line of s becomes one line inside the
+// box, left aligned and padded to the widest line (measured in display cells).
+func Box(s string, style BoxStyle) string {
+	lines := strings.Split(s, "\n")
+	padding := max(style.Padding, 0)
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, DisplayWidth(line))
+	}
+
+	title := ""
+	if style.Title != "" {
+		title = " " + style.Title + " "
+	}
+	// The title is preceded by one horizontal rule so it does not touch the corner
+	inner := max(width+2*padding, DisplayWidth(title)+1)
+
+	var result strings.Builder
+	result.WriteString(style.TopLeft)
+	if title != "" {
+		result.WriteString(style.Horizontal)
+		result.WriteString(title)
+		result.WriteString(strings.Repeat(style.Horizontal, inner-1-DisplayWidth(title)))
+	} else {
+		result.WriteString(strings.Repeat(style.Horizontal, inner))
+	}
+	result.WriteString(style.TopRight)
+	result.WriteByte('\n')
+
+	pad := strings.Repeat(" ", padding)
+	for _, line := range lines {
+		result.WriteString(style.Vertical)
+		result.WriteString(pad)
+		result.WriteString(padWidth(line, inner-2*padding, AlignLeft))
+		result.WriteString(pad)
+		result.WriteString(style.Vertical)
+		result.WriteByte('\n')
+	}
+
+	result.WriteString(style.BottomLeft)
+	result.WriteString(strings.Repeat(style.Horizontal, inner))
+	result.WriteString(style.BottomRight)
+
+	return result.String()
+}
@@ -0,0 +1,78 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestBox(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		style    sx.BoxStyle
+		expected string
+	}{
+		{
+			name:  "ascii",
+			input: "hello\nworld!",
+			style: sx.BoxASCII,
+			expected: "+--------+\n" +
+				"| hello  |\n" +
+				"| world! |\n" +
+				"+--------+",
+		},
+		{
+			name:  "unicode single",
+			input: "hi",
+			style: sx.BoxSingle,
+			expected: "┌────┐\n" +
+				"│ hi │\n" +
+				"└────┘",
+		},
+		{
+			name:  "title",
+			input: "do not edit",
+			style: sx.BoxRounded.WithTitle("Generated"),
+			expected: "╭─ Generated ─╮\n" +
+				"│ do not edit │\n" +
+				"╰─────────────╯",
+		},
+		{
+			name:  "title wider than text",
+			input: "x",
+			style: sx.BoxASCII.WithTitle("Warning"),
+			expected: "+- Warning +\n" +
+				"| x        |\n" +
+				"+----------+",
+		},
+		{
+			name:  "padding",
+			input: "ok",
+			style: sx.BoxDouble.WithPadding(3),
+			expected: "╔════════╗\n" +
+				"║   ok   ║\n" +
+				"╚════════╝",
+		},
+		{
+			name:  "wide characters",
+			input: "日本\nab",
+			style: sx.BoxASCII.WithPadding(0),
+			expected: "+----+\n" +
+				"|日本|\n" +
+				"|ab  |\n" +
+				"+----+",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Box(tt.input, tt.style)
+			if result != tt.expected {
+				t.Errorf("Box(%q) =\n%s\nwant\n%s", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// latinAlphabets lists the runs of 26 Latin letters that Caesar rotates:
+// ASCII and fullwidth, upper and lower case
+var latinAlphabets = []rune{'A', 'a', 'Ａ', 'ａ'}
+
+// Caesar shifts every Latin letter of s by shift positions within its
+// alphabet, wrapping around and preserving case. Negative shifts rotate
+// backwards. ASCII and fullwidth Latin letters are rotated; all other
+// runes, including accented letters, are left unchanged.
+func Caesar(s string, shift int) string {
+	shift %= 26
+	if shift < 0 {
+		shift += 26
+	}
+	if shift == 0 {
+		return s
+	}
+
+	return strings.Map(func(r rune) rune {
+		for _, first := range latinAlphabets {
+			if r >= first && r < first+26 {
+				return first + (r-first+rune(shift))%26
+			}
+		}
+		return r
+	}, s)
+}
+
+// ROT13 rotates Latin letters by 13 positions. Applying it twice restores the input.
+func ROT13(s string) string {
+	return Caesar(s, 13)
+}
@@ -0,0 +1,48 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestCaesar(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		shift    int
+		expected string
+	}{
+		{name: "shift 3", input: "Hello, World!", shift: 3, expected: "Khoor, Zruog!"},
+		{name: "wrap around", input: "xyz XYZ", shift: 3, expected: "abc ABC"},
+		{name: "negative", input: "abc", shift: -1, expected: "zab"},
+		{name: "large shift", input: "abc", shift: 53, expected: "bcd"},
+		{name: "zero", input: "abc", shift: 26, expected: "abc"},
+		{name: "accents untouched", input: "caf\u00e9", shift: 1, expected: "dbg\u00e9"},
+		{name: "fullwidth", input: "Ａｂｚ", shift: 1, expected: "Ｂｃａ"},
+		{name: "digits untouched", input: "r2d2", shift: 1, expected: "s2e2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Caesar(tt.input, tt.shift)
+			if result != tt.expected {
+				t.Errorf("Caesar(%q, %d) = %q, want %q", tt.input, tt.shift, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestROT13(t *testing.T) {
+	input := "Why did the chicken cross the road?"
+	expected := "Jul qvq gur puvpxra pebff gur ebnq?"
+
+	if result := sx.ROT13(input); result != expected {
+		t.Errorf("ROT13(%q) = %q, want %q", input, result, expected)
+	}
+	if result := sx.ROT13(sx.ROT13(input)); result != input {
+		t.Errorf("ROT13 twice = %q, want %q", result, input)
+	}
+}
@@ -0,0 +1,195 @@
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// CaseStyle identifies the naming convention an identifier is written in
+type CaseStyle int
+
+const (
+	// StyleUnknown is reported for empty input
+	StyleUnknown CaseStyle = iota
+	// StyleMixed is reported for identifiers that follow no single convention
+	StyleMixed
+	// StyleFlat is a single lowercase word: flatcase
+	StyleFlat
+	// StyleCamel is camelCase
+	StyleCamel
+	// StylePascal is PascalCase
+	StylePascal
+	// StyleSnake is snake_case
+	StyleSnake
+	// StyleScreamingSnake is SCREAMING_SNAKE_CASE
+	StyleScreamingSnake
+	// StyleKebab is kebab-case
+	StyleKebab
+	// StyleTrain is Train-Case
+	StyleTrain
+)
+
+// caseStyles lists the concrete styles in detection order, most specific first
+var caseStyles = []CaseStyle{
+	StyleFlat, StyleCamel, StylePascal, StyleSnake, StyleScreamingSnake, StyleKebab, StyleTrain,
+}
+
+// String returns the conventional name of the style
+func (c CaseStyle) String() string {
+	switch c {
+	case StyleMixed:
+		return "mixed"
+	case StyleFlat:
+		return "flatcase"
+	case StyleCamel:
+		return "camelCase"
+	case StylePascal:
+		return "PascalCase"
+	case StyleSnake:
+		return "snake_case"
+	case StyleScreamingSnake:
+		return "SCREAMING_SNAKE_CASE"
+	case StyleKebab:
+		return "kebab-case"
+	case StyleTrain:
+		return "Train-Case"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectCase classifies the identifier s into the most specific style it
+// conforms to, StyleMixed if it follows none and StyleUnknown if it is empty.
+// A single lowercase word is reported as StyleFlat, although it is valid
+// camelCase, snake_case and kebab-case as well; the Is functions accept it
+// in each of those styles.
+//
+// Example:
+//
+//	DetectCase("userId")  // StyleCamel
+//	DetectCase("USER_ID") // StyleScreamingSnake
+//	DetectCase("user_Id") // StyleMixed
+func DetectCase(s string) CaseStyle {
+	if s == "" {
+		return StyleUnknown
+	}
+	for _, style := range caseStyles {
+		if conformsTo(s, style) {
+			return style
+		}
+	}
+	return StyleMixed
+}
+
+// IsFlatCase reports whether s is a single word in lower case: flatcase
+func IsFlatCase(s string) bool {
+	return conformsTo(s, StyleFlat)
+}
+
+// IsCamelCase reports whether s is camelCase
+func IsCamelCase(s string) bool {
+	return conformsTo(s, StyleCamel)
+}
+
+// IsPascalCase reports whether s is PascalCase
+func IsPascalCase(s string) bool {
+	return conformsTo(s, StylePascal)
+}
+
+// IsSnakeCase reports whether s is snake_case
+func IsSnakeCase(s string) bool {
+	return conformsTo(s, StyleSnake)
+}
+
+// IsScreamingSnakeCase reports whether s is SCREAMING_SNAKE_CASE
+func IsScreamingSnakeCase(s string) bool {
+	return conformsTo(s, StyleScreamingSnake)
+}
+
+// IsKebabCase reports whether s is kebab-case
+func IsKebabCase(s string) bool {
+	return conformsTo(s, StyleKebab)
+}
+
+// IsTrainCase reports whether s is Train-Case
+func IsTrainCase(s string) bool {
+	return conformsTo(s, StyleTrain)
+}
+
+// conformsTo reports whether s is a valid identifier in the given style.
+// A single lowercase word conforms to flat, camel, snake and kebab case alike.
+func conformsTo(s string, style CaseStyle) bool {
+	switch style {
+	case StyleFlat:
+		return validWords(s, 0, isLowerOrDigit)
+	case StyleCamel:
+		first, _ := utf8.DecodeRuneInString(s)
+		return isLower(first) && validWords(s, 0, isLetterOrDigit)
+	case StylePascal:
+		first, size := utf8.DecodeRuneInString(s)
+		return isUpper(first) && validWords(s, 0, isLetterOrDigit) &&
+			(size == len(s) || strings.IndexFunc(s, isLower) >= 0)
+	case StyleSnake:
+		return validWords(s, '_', isLowerOrDigit)
+	case StyleScreamingSnake:
+		return validWords(s, '_', isUpperOrDigit) && strings.IndexFunc(s, isUpper) >= 0
+	case StyleKebab:
+		return validWords(s, '-', isLowerOrDigit)
+	case StyleTrain:
+		for word := range strings.SplitSeq(s, "-") {
+			if word == "" || !conformsTo(word, StylePascal) {
+				return false
+			}
+			if _, size := utf8.DecodeRuneInString(word); strings.IndexFunc(word[size:], isUpper) >= 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// validWords reports whether s consists of non-empty words joined by sep
+// (0 for none) whose runes all satisfy valid, and starts with a letter
+func validWords(s string, sep rune, valid func(rune) bool) bool {
+	if s == "" {
+		return false
+	}
+
+	prevSep := true
+	for i, r := range s {
+		if sep != 0 && r == sep {
+			if prevSep {
+				return false
+			}
+			prevSep = true
+			continue
+		}
+		if !valid(r) || i == 0 && !isLetter(r) {
+			return false
+		}
+		prevSep = false
+	}
+
+	return !prevSep
+}
+
+// isLowerOrDigit reports whether r is a lowercase letter, an uncased letter or a digit
+func isLowerOrDigit(r rune) bool {
+	return isDigit(r) || isLetter(r) && !isUpper(r)
+}
+
+// isUpperOrDigit reports whether r is an uppercase letter, an uncased letter or a digit
+func isUpperOrDigit(r rune) bool {
+	return isDigit(r) || isLetter(r) && !isLower(r)
+}
+
+// isLetterOrDigit reports whether r is a letter or a digit
+func isLetterOrDigit(r rune) bool {
+	return isLetter(r) || isDigit(r)
+}
+
+// isASCIIAlnum reports whether c is an ASCII letter or digit
+func isASCIIAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
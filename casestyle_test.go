@@ -0,0 +1,71 @@
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestDetectCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected sx.CaseStyle
+	}{
+		{input: "", expected: sx.StyleUnknown},
+		{input: "user", expected: sx.StyleFlat},
+		{input: "userId", expected: sx.StyleCamel},
+		{input: "UserId", expected: sx.StylePascal},
+		{input: "User", expected: sx.StylePascal},
+		{input: "user_id", expected: sx.StyleSnake},
+		{input: "USER_ID", expected: sx.StyleScreamingSnake},
+		{input: "USER", expected: sx.StyleScreamingSnake},
+		{input: "user-id", expected: sx.StyleKebab},
+		{input: "User-Id", expected: sx.StyleTrain},
+		{input: "user_Id", expected: sx.StyleMixed},
+		{input: "user__id", expected: sx.StyleMixed},
+		{input: "user id", expected: sx.StyleMixed},
+		{input: "1user", expected: sx.StyleMixed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := sx.DetectCase(tt.input); result != tt.expected {
+				t.Errorf("DetectCase(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		function func(string) bool
+		input    string
+		expected bool
+	}{
+		{name: "IsFlatCase", function: sx.IsFlatCase, input: "username", expected: true},
+		{name: "IsFlatCase", function: sx.IsFlatCase, input: "userName", expected: false},
+		{name: "IsCamelCase", function: sx.IsCamelCase, input: "userName", expected: true},
+		{name: "IsCamelCase", function: sx.IsCamelCase, input: "user", expected: true},
+		{name: "IsCamelCase", function: sx.IsCamelCase, input: "UserName", expected: false},
+		{name: "IsPascalCase", function: sx.IsPascalCase, input: "HTTPServer", expected: true},
+		{name: "IsPascalCase", function: sx.IsPascalCase, input: "HTTP", expected: false},
+		{name: "IsSnakeCase", function: sx.IsSnakeCase, input: "user_name_2", expected: true},
+		{name: "IsSnakeCase", function: sx.IsSnakeCase, input: "_user", expected: false},
+		{name: "IsScreamingSnakeCase", function: sx.IsScreamingSnakeCase, input: "MAX_RETRIES", expected: true},
+		{name: "IsScreamingSnakeCase", function: sx.IsScreamingSnakeCase, input: "Max_Retries", expected: false},
+		{name: "IsKebabCase", function: sx.IsKebabCase, input: "user-name", expected: true},
+		{name: "IsKebabCase", function: sx.IsKebabCase, input: "user-", expected: false},
+		{name: "IsTrainCase", function: sx.IsTrainCase, input: "Content-Type", expected: true},
+		{name: "IsTrainCase", function: sx.IsTrainCase, input: "Content-type", expected: false},
+		{name: "IsTrainCase", function: sx.IsTrainCase, input: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.input, func(t *testing.T) {
+			if result := tt.function(tt.input); result != tt.expected {
+				t.Errorf("%s(%q) = %v, want %v", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"slices"
+	"strings"
+)
+
+// Chain applies a sequence of transformations to a string, so multi-step
+// pipelines read left to right without intermediate variables. Each method
+// returns a new Chain and leaves its receiver unchanged, so a Chain can be
+// shared and branched.
+//
+// Example:
+//
+//	New("XMLHttpRequest").Normalize().Snake().Upper().Truncate(10).String() // XML_HTTP_R
+type Chain struct {
+	s    string
+	opts []Option
+}
+
+// New returns a Chain holding s. opts apply to the case conversions of the
+// chain.
+func New(s string, opts ...Option) Chain {
+	return Chain{s: s, opts: slices.Clip(opts)}
+}
+
+// String returns the result of the chain
+func (c Chain) String() string {
+	return c.s
+}
+
+// Words returns the words of the result, see SplitByCase
+func (c Chain) Words() Words {
+	return SplitByCase(c.s, c.opts...)
+}
+
+// With adds opts to the options of the case conversions that follow
+func (c Chain) With(opts ...Option) Chain {
+	// Clip so that branches of a shared Chain never append into the same array
+	c.opts = slices.Clip(append(c.opts, opts...))
+	return c
+}
+
+// Normalize makes the case conversions that follow normalize words, see
+// WithNormalize
+func (c Chain) Normalize() Chain {
+	return c.With(WithNormalize(true))
+}
+
+// Map replaces the result with fn applied to it
+func (c Chain) Map(fn func(string) string) Chain {
+	c.s = fn(c.s)
+	return c
+}
+
+// Pascal converts the result to PascalCase, see PascalCase
+func (c Chain) Pascal() Chain {
+	c.s = PascalCase(c.s, c.opts...)
+	return c
+}
+
+// Camel converts the result to camelCase, see CamelCase
+func (c Chain) Camel() Chain {
+	c.s = CamelCase(c.s, c.opts...)
+	return c
+}
+
+// Kebab converts the result to kebab-case, see KebabCase
+func (c Chain) Kebab() Chain {
+	return c.Delimited("-")
+}
+
+// Snake converts the result to snake_case, see SnakeCase
+func (c Chain) Snake() Chain {
+	return c.Delimited("_")
+}
+
+// Space converts the result to lowercase words separated by spaces, see
+// SpaceCase
+func (c Chain) Space() Chain {
+	return c.Delimited(" ")
+}
+
+// Train converts the result to Train-Case, see TrainCase
+func (c Chain) Train() Chain {
+	c.s = TrainCase(c.s, c.opts...)
+	return c
+}
+
+// Flat converts the result to flatcase, see FlatCase
+func (c Chain) Flat() Chain {
+	return c.Delimited("")
+}
+
+// Delimited converts the result to lowercase words joined by separator, see
+// DelimitedCase
+func (c Chain) Delimited(separator string) Chain {
+	c.s = DelimitedCase(c.s, separator, c.opts...)
+	return c
+}
+
+// Upper converts the result to upper case
+func (c Chain) Upper() Chain {
+	return c.Map(strings.ToUpper)
+}
+
+// Lower converts the result to lower case
+func (c Chain) Lower() Chain {
+	return c.Map(strings.ToLower)
+}
+
+// UpperFirst converts the first character of the result to upper case
+func (c Chain) UpperFirst() Chain {
+	return c.Map(UpperFirst)
+}
+
+// LowerFirst converts the first character of the result to lower case
+func (c Chain) LowerFirst() Chain {
+	return c.Map(LowerFirst)
+}
+
+// TrimSpace removes leading and trailing white space from the result
+func (c Chain) TrimSpace() Chain {
+	return c.Map(strings.TrimSpace)
+}
+
+// Truncate shortens the result to at most n grapheme clusters, so combining
+// sequences and emoji are never cut in half
+func (c Chain) Truncate(n int) Chain {
+	end := 0
+	for ; n > 0 && end < len(c.s); n-- {
+		end += nextGrapheme(c.s[end:])
+	}
+	c.s = c.s[:end]
+	return c
+}
@@ -0,0 +1,63 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name     string
+		chain    sx.Chain
+		expected string
+	}{
+		{name: "unchanged", chain: sx.New("XMLHttpRequest"), expected: "XMLHttpRequest"},
+		{name: "pipeline", chain: sx.New("XMLHttpRequest").Normalize().Snake().Upper().Truncate(20), expected: "XML_HTTP_REQUEST"},
+		{name: "truncated", chain: sx.New("XMLHttpRequest").Normalize().Snake().Upper().Truncate(10), expected: "XML_HTTP_R"},
+		{name: "pascal", chain: sx.New("user_id").Pascal(), expected: "UserId"},
+		{name: "options", chain: sx.New("user_id", sx.WithAcronyms("ID")).Pascal(), expected: "UserID"},
+		{name: "with", chain: sx.New("user_id").With(sx.WithAcronyms("ID")).Camel(), expected: "userID"},
+		{name: "kebab", chain: sx.New("fooBar").Kebab(), expected: "foo-bar"},
+		{name: "train", chain: sx.New("foo_bar").Train(), expected: "Foo-Bar"},
+		{name: "flat", chain: sx.New("foo_bar").Flat(), expected: "foobar"},
+		{name: "space", chain: sx.New("fooBar").Space(), expected: "foo bar"},
+		{name: "delimited", chain: sx.New("fooBar").Delimited("."), expected: "foo.bar"},
+		{name: "lower", chain: sx.New("FOO").Lower().UpperFirst(), expected: "Foo"},
+		{name: "lower first", chain: sx.New("FooBar").LowerFirst(), expected: "fooBar"},
+		{name: "trim and map", chain: sx.New("  fooBar ").TrimSpace().Map(func(s string) string { return s + "s" }), expected: "fooBars"},
+		{name: "truncate graphemes", chain: sx.New("e\u0301te\u0301").Truncate(2), expected: "e\u0301t"},
+		{name: "truncate longer", chain: sx.New("abc").Truncate(5), expected: "abc"},
+		{name: "truncate negative", chain: sx.New("abc").Truncate(-1), expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.chain.String(); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestChainBranches(t *testing.T) {
+	base := sx.New("user_id_url", sx.WithAcronyms("ID"))
+	withURL := base.With(sx.WithAcronyms("URL"))
+	normalized := base.Normalize()
+
+	if result := base.Pascal().String(); result != "UserIDUrl" {
+		t.Errorf("base = %q, want %q", result, "UserIDUrl")
+	}
+	if result := withURL.Pascal().String(); result != "UserIDURL" {
+		t.Errorf("with URL = %q, want %q", result, "UserIDURL")
+	}
+	if result := normalized.Pascal().String(); result != "UserIDUrl" {
+		t.Errorf("normalized = %q, want %q", result, "UserIDUrl")
+	}
+	if words := base.Upper().Words(); strings.Join(words, " ") != "USER ID URL" {
+		t.Errorf("words = %q, want %q", words, "USER ID URL")
+	}
+}
@@ -0,0 +1,118 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"context"
+	"math"
+	"unicode/utf8"
+)
+
+// Cluster groups strings that are similar to each other, such as log
+// messages differing in a few values or spellings of the same product name.
+// Two strings are similar when their similarity, the share of runes of the
+// longer one that need no edit to turn one into the other, is at least
+// threshold (between 0 and 1); groups are the strings connected by a chain
+// of similar pairs. Groups are returned in order of their first string, and
+// strings keep their input order within a group, so the first string of each
+// group can serve as its canonical form.
+//
+// Most pairs are ruled out by counting their shared trigrams, so only likely
+// matches are compared by edit distance.
+//
+// Example:
+//
+//	Cluster([]string{"connection timeout", "connection timed out", "disk full", "disk ful"}, 0.8)
+//	// [[connection timeout connection timed out] [disk full disk ful]]
+func Cluster(ss []string, threshold float64) [][]string {
+	clusters, _ := ClusterContext(context.Background(), ss, threshold)
+	return clusters
+}
+
+// ClusterContext is like Cluster, but returns early with the context's error
+// if ctx is done before the clustering finishes
+func ClusterContext(ctx context.Context, ss []string, threshold float64) ([][]string, error) {
+	lengths := make([]int, len(ss))
+	grams := make([]map[string]int, len(ss))
+	postings := make(map[string][]trigramCount)
+	for i, s := range ss {
+		lengths[i] = utf8.RuneCountInString(s)
+		grams[i] = trigramCounts(s)
+		for gram, n := range grams[i] {
+			postings[gram] = append(postings[gram], trigramCount{i, n})
+		}
+	}
+
+	parent := make([]int, len(ss))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	shared := make([]int, len(ss))
+	for i, s := range ss {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		clear(shared)
+		for gram, n := range grams[i] {
+			for _, p := range postings[gram] {
+				shared[p.index] += min(n, p.count)
+			}
+		}
+
+		for j := i + 1; j < len(ss); j++ {
+			if find(i) == find(j) {
+				continue
+			}
+			// Strings within k edits differ in length by at most k and, by the
+			// q-gram lemma, share at least longer-2-3k trigrams. k is rounded
+			// up so that no similar pair is ruled out.
+			longer, shorter := max(lengths[i], lengths[j]), min(lengths[i], lengths[j])
+			k := int(math.Ceil((1 - threshold) * float64(longer)))
+			if longer-shorter > k || shared[j] < longer-2-3*k {
+				continue
+			}
+			if similarity(s, ss[j]) >= threshold {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	var clusters [][]string
+	index := make(map[int]int)
+	for i, s := range ss {
+		root := find(i)
+		c, ok := index[root]
+		if !ok {
+			c = len(clusters)
+			index[root] = c
+			clusters = append(clusters, nil)
+		}
+		clusters[c] = append(clusters[c], s)
+	}
+	return clusters, nil
+}
+
+// trigramCount is the number of occurrences of a trigram in the string with
+// the given index
+type trigramCount struct {
+	index, count int
+}
+
+// trigramCounts returns the number of occurrences of each sequence of three
+// runes in s
+func trigramCounts(s string) map[string]int {
+	offsets := runeOffsets(s)
+	counts := make(map[string]int, max(len(offsets)-3, 0))
+	for i := 0; i+3 < len(offsets); i++ {
+		counts[s[offsets[i]:offsets[i+3]]]++
+	}
+	return counts
+}
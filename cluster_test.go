@@ -0,0 +1,75 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestCluster(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []string
+		threshold float64
+		expected  [][]string
+	}{
+		{
+			name:      "log messages",
+			input:     []string{"connection timeout", "disk full", "connection timed out", "disk ful"},
+			threshold: 0.8,
+			expected:  [][]string{{"connection timeout", "connection timed out"}, {"disk full", "disk ful"}},
+		},
+		{
+			name:      "chained",
+			input:     []string{"abcdefgh", "abcdefgx", "abcdefyx"},
+			threshold: 0.85,
+			expected:  [][]string{{"abcdefgh", "abcdefgx", "abcdefyx"}},
+		},
+		{
+			name:      "duplicates kept",
+			input:     []string{"iPhone 15", "Galaxy S24", "iPhone 15", "iphone 15"},
+			threshold: 0.85,
+			expected:  [][]string{{"iPhone 15", "iPhone 15", "iphone 15"}, {"Galaxy S24"}},
+		},
+		{
+			name:      "short strings",
+			input:     []string{"ab", "ac", "xy"},
+			threshold: 0.5,
+			expected:  [][]string{{"ab", "ac"}, {"xy"}},
+		},
+		{
+			name:      "threshold one",
+			input:     []string{"a", "b", "a"},
+			threshold: 1,
+			expected:  [][]string{{"a", "a"}, {"b"}},
+		},
+		{
+			name:      "threshold zero",
+			input:     []string{"abc", "xyz", ""},
+			threshold: 0,
+			expected:  [][]string{{"abc", "xyz", ""}},
+		},
+		{name: "empty", input: nil, threshold: 0.8, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.Cluster(tt.input, tt.threshold); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Cluster(%q, %v) = %q, want %q", tt.input, tt.threshold, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClusterContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result, err := sx.ClusterContext(ctx, []string{"a", "b"}, 0.5); !errors.Is(err, context.Canceled) || result != nil {
+		t.Errorf("ClusterContext(canceled) = %q, %v, want nil, context.Canceled", result, err)
+	}
+}
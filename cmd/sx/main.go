@@ -0,0 +1,242 @@
+//go:build !sx_ascii
+
+// Command sx exposes the sx string conversions to shell scripts.
+//
+// Usage:
+//
+//	sx <style> [flags] [words...]
+//	sx json-keys --to <style> [--indent str] < in.json
+//
+// Styles are camel, pascal, snake, kebab, train, flat and space. Words are
+// converted one per output line; with --stdin each input line is converted
+// instead. json-keys converts the object keys of JSON values read from
+// standard input, keeping key order and leaving values unchanged. sx exits
+// with status 1 when reading, converting or writing fails and 2 when the
+// command line is wrong.
+//
+// Examples:
+//
+//	sx snake FooBar                    # foo_bar
+//	sx kebab --stdin < names.txt
+//	sx camel --acronyms ID,URL user_id # userID
+//	sx json-keys --to camel < in.json
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/gomantics/sx"
+)
+
+// converters maps style names to conversions
+var converters = map[string]func(s string, opts ...sx.Option) string{
+	"camel":  sx.CamelCase[string],
+	"pascal": sx.PascalCase[string],
+	"snake":  sx.SnakeCase[string],
+	"kebab":  func(s string, opts ...sx.Option) string { return sx.DelimitedCase(s, "-", opts...) },
+	"train":  sx.TrainCase[string],
+	"flat":   sx.FlatCase[string],
+	"space":  sx.SpaceCase[string],
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	var err error
+	switch command := args[0]; {
+	case command == "json-keys":
+		err = runJSONKeys(args[1:], stdin, stdout, stderr)
+	case converters[command] != nil:
+		err = runConvert(command, args[1:], stdin, stdout, stderr)
+	case command == "help" || command == "-h" || command == "--help":
+		usage(stdout)
+		return 0
+	default:
+		err = usageErrorf("unknown command %q", command)
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "sx:", err)
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// usageError reports a command line that cannot be run, as opposed to a
+// failure while running it
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+// usageErrorf returns a *usageError with a formatted message
+func usageErrorf(format string, args ...any) error {
+	return &usageError{err: fmt.Errorf(format, args...)}
+}
+
+// parseFlags parses args into flags, reporting problems to stderr
+func parseFlags(flags *flag.FlagSet, args []string, stderr io.Writer) error {
+	flags.SetOutput(stderr)
+	if err := flags.Parse(args); err != nil {
+		return &usageError{err: err}
+	}
+	return nil
+}
+
+// usage writes the command summary
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: sx <%s> [--stdin] [--acronyms list] [--normalize] [words...]\n", strings.Join(styleNames(), "|"))
+	fmt.Fprintln(w, "       sx json-keys --to <style> [--indent str] < in.json")
+}
+
+// styleNames returns the supported style names in order
+func styleNames() []string {
+	names := make([]string, 0, len(converters))
+	for name := range converters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// caseFlags registers the flags shared by all conversions and returns a
+// function building the options they select
+func caseFlags(flags *flag.FlagSet) func() []sx.Option {
+	acronyms := flags.String("acronyms", "", "comma-separated acronyms rendered in their given spelling, e.g. ID,URL")
+	normalize := flags.Bool("normalize", false, "lowercase words before capitalizing them")
+	return func() []sx.Option {
+		var opts []sx.Option
+		if *acronyms != "" {
+			opts = append(opts, sx.WithAcronyms(strings.Split(*acronyms, ",")...))
+		}
+		if *normalize {
+			opts = append(opts, sx.WithNormalize(true))
+		}
+		return opts
+	}
+}
+
+// runConvert converts words or standard input lines to a style
+func runConvert(style string, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet(style, flag.ContinueOnError)
+	fromStdin := flags.Bool("stdin", false, "convert each line of standard input")
+	options := caseFlags(flags)
+	if err := parseFlags(flags, args, stderr); err != nil {
+		return err
+	}
+	if !*fromStdin && flags.NArg() == 0 {
+		return usageErrorf("no words to convert; pass words or --stdin")
+	}
+
+	w := bufio.NewWriter(stdout)
+	err := convertLines(w, converters[style], options(), flags.Args(), *fromStdin, stdin)
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// convertLines writes the conversion of each word, or of each line of stdin
+// when fromStdin is set, on a line of its own
+func convertLines(w io.Writer, convert func(s string, opts ...sx.Option) string, opts []sx.Option, words []string, fromStdin bool, stdin io.Reader) error {
+	if !fromStdin {
+		for _, word := range words {
+			if _, err := fmt.Fprintln(w, convert(word, opts...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		if _, err := fmt.Fprintln(w, convert(scanner.Text(), opts...)); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// runJSONKeys converts the object keys of JSON values on standard input
+func runJSONKeys(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("json-keys", flag.ContinueOnError)
+	to := flags.String("to", "", "target style: "+strings.Join(styleNames(), ", "))
+	indent := flags.String("indent", "", "indent output with this string instead of writing it compactly")
+	options := caseFlags(flags)
+	if err := parseFlags(flags, args, stderr); err != nil {
+		return err
+	}
+	convert := converters[*to]
+	if convert == nil {
+		return usageErrorf("unknown or missing --to style %q", *to)
+	}
+
+	w := bufio.NewWriter(stdout)
+	err := convertJSONStream(w, json.NewDecoder(stdin), convert, options(), *indent)
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	return err
+}
+
+// convertJSONStream writes each JSON value read from dec with its object keys
+// converted, one per line, indented with indent unless it is empty
+func convertJSONStream(w io.Writer, dec *json.Decoder, convert func(s string, opts ...sx.Option) string, opts []sx.Option, indent string) error {
+	for {
+		var value json.RawMessage
+		if err := dec.Decode(&value); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		converted, err := sx.ConvertJSONKeys(value, convert, sx.WithCaseOptions(opts...))
+		if err != nil {
+			return err
+		}
+
+		buf := bytes.NewBuffer(converted)
+		if indent != "" {
+			var indented bytes.Buffer
+			if err := json.Indent(&indented, converted, "", indent); err != nil {
+				return err
+			}
+			buf = &indented
+		}
+		buf.WriteByte('\n')
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+//go:build !sx_ascii
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		expected string
+		code     int
+	}{
+		{name: "snake", args: []string{"snake", "FooBar"}, expected: "foo_bar\n"},
+		{name: "several words", args: []string{"kebab", "FooBar", "HTTPServer"}, expected: "foo-bar\nhttp-server\n"},
+		{name: "pascal", args: []string{"pascal", "user-name"}, expected: "UserName\n"},
+		{name: "space", args: []string{"space", "XMLHttpRequest"}, expected: "xml http request\n"},
+		{name: "acronyms", args: []string{"camel", "--acronyms", "ID,URL", "user_id"}, expected: "userID\n"},
+		{name: "normalize", args: []string{"pascal", "--normalize", "FOO_BAR"}, expected: "FooBar\n"},
+		{name: "stdin", args: []string{"kebab", "--stdin"}, stdin: "FooBar\nhttp_server\n", expected: "foo-bar\nhttp-server\n"},
+		{name: "stdin without final newline", args: []string{"flat", "--stdin"}, stdin: "Foo_Bar", expected: "foobar\n"},
+		{
+			name:     "json keys",
+			args:     []string{"json-keys", "--to", "camel"},
+			stdin:    `{"user_id": 1, "nested_obj": {"first_name": "<b>", "tags": [{"tag_name": null}]}, "a_b": 1.50}`,
+			expected: `{"userId":1,"nestedObj":{"firstName":"<b>","tags":[{"tagName":null}]},"aB":1.50}` + "\n",
+		},
+		{
+			name:     "json keys indented",
+			args:     []string{"json-keys", "--to", "snake", "--indent", "  "},
+			stdin:    `{"userId": [1, 2]}`,
+			expected: "{\n  \"user_id\": [\n    1,\n    2\n  ]\n}\n",
+		},
+		{name: "json stream", args: []string{"json-keys", "--to", "kebab"}, stdin: `{"fooBar":1} {"bazQux":2}`, expected: "{\"foo-bar\":1}\n{\"baz-qux\":2}\n"},
+		{name: "json truncated", args: []string{"json-keys", "--to", "camel"}, stdin: `{"a":`, code: 1},
+		{name: "json duplicate keys", args: []string{"json-keys", "--to", "snake"}, stdin: `{"userId":1,"user_id":2}`, code: 1},
+		{name: "json missing style", args: []string{"json-keys"}, stdin: `{}`, code: 2},
+		{name: "no words", args: []string{"snake"}, code: 2},
+		{name: "unknown command", args: []string{"shout", "x"}, code: 2},
+		{name: "no command", args: nil, code: 2},
+		{name: "unknown flag", args: []string{"snake", "--shout", "x"}, code: 2},
+		{name: "help flag", args: []string{"json-keys", "-h"}, code: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.code {
+				t.Fatalf("run(%q) = %d, want %d (stderr %q)", tt.args, code, tt.code, stderr.String())
+			}
+			if tt.code == 0 && stdout.String() != tt.expected {
+				t.Errorf("run(%q) wrote %q, want %q", tt.args, stdout.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunFlagOutput(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	run([]string{"snake", "-h"}, strings.NewReader(""), &stdout, &stderr)
+	if !strings.Contains(stderr.String(), "-stdin") || stdout.Len() != 0 {
+		t.Errorf("run(snake -h) wrote stdout %q and stderr %q, want the flag usage on stderr", stdout.String(), stderr.String())
+	}
+}
+
+// failingWriter fails every write, like a closed pipe
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestRunWriteError(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		stdin string
+	}{
+		{name: "words", args: []string{"snake", "FooBar"}},
+		{name: "stdin", args: []string{"snake", "--stdin"}, stdin: "FooBar\n"},
+		{name: "json keys", args: []string{"json-keys", "--to", "snake"}, stdin: `{"fooBar":1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			if code := run(tt.args, strings.NewReader(tt.stdin), failingWriter{}, &stderr); code != 1 {
+				t.Errorf("run(%q) = %d, want 1 (stderr %q)", tt.args, code, stderr.String())
+			}
+		})
+	}
+}
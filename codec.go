@@ -0,0 +1,144 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"encoding/base32"
+	"fmt"
+	"strings"
+)
+
+const (
+	base62Alphabet    = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	base58Alphabet    = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+var crockfordEncoding = base32.NewEncoding(crockfordAlphabet).WithPadding(base32.NoPadding)
+
+// EncodeBase62 encodes data using the alphanumeric alphabet 0-9A-Za-z.
+// Leading zero bytes are preserved as leading '0' characters.
+func EncodeBase62(data []byte) string {
+	return encodeBaseX(data, base62Alphabet)
+}
+
+// DecodeBase62 decodes a string produced by EncodeBase62
+func DecodeBase62(s string) ([]byte, error) {
+	return decodeBaseX(s, base62Alphabet, "base62")
+}
+
+// EncodeBase58 encodes data using the Bitcoin base58 alphabet, which omits
+// the easily confused characters 0, O, I and l.
+// Leading zero bytes are preserved as leading '1' characters.
+func EncodeBase58(data []byte) string {
+	return encodeBaseX(data, base58Alphabet)
+}
+
+// DecodeBase58 decodes a string produced by EncodeBase58
+func DecodeBase58(s string) ([]byte, error) {
+	return decodeBaseX(s, base58Alphabet, "base58")
+}
+
+// EncodeCrockfordBase32 encodes data using Douglas Crockford's base32
+// alphabet, without padding
+func EncodeCrockfordBase32(data []byte) string {
+	return crockfordEncoding.EncodeToString(data)
+}
+
+// DecodeCrockfordBase32 decodes Crockford base32. Decoding is
+// case-insensitive, hyphens are ignored, and the commonly misread letters
+// I and L are read as 1 and O as 0.
+func DecodeCrockfordBase32(s string) ([]byte, error) {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case '-':
+			return -1
+		case 'i', 'I', 'l', 'L':
+			return '1'
+		case 'o', 'O':
+			return '0'
+		}
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, s)
+
+	data, err := crockfordEncoding.DecodeString(normalized)
+	if corrupt, ok := err.(base32.CorruptInputError); ok {
+		return nil, &SyntaxError{Msg: "invalid crockford base32 input", Offset: int(corrupt)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// encodeBaseX encodes data as a big-endian number in the given alphabet,
+// mapping each leading zero byte to the alphabet's first character
+func encodeBaseX(data []byte, alphabet string) string {
+	base := len(alphabet)
+
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// Repeated division of the big-endian number, collecting digits in reverse
+	digits := make([]byte, 0, len(data)*138/100+1)
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % base)
+			carry /= base
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%base))
+			carry /= base
+		}
+	}
+
+	var result strings.Builder
+	result.Grow(zeros + len(digits))
+	for range zeros {
+		result.WriteByte(alphabet[0])
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		result.WriteByte(alphabet[digits[i]])
+	}
+	return result.String()
+}
+
+// decodeBaseX reverses encodeBaseX
+func decodeBaseX(s, alphabet, name string) ([]byte, error) {
+	base := len(alphabet)
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	var bytes []byte
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(alphabet, s[i])
+		if carry < 0 {
+			return nil, &SyntaxError{Msg: fmt.Sprintf("invalid %s character %q", name, s[i]), Offset: i}
+		}
+		for j := range bytes {
+			carry += int(bytes[j]) * base
+			bytes[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			bytes = append(bytes, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	result := make([]byte, zeros+len(bytes))
+	for i, b := range bytes {
+		result[len(result)-1-i] = b
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestBaseXCodecs(t *testing.T) {
+	codecs := []struct {
+		name   string
+		encode func([]byte) string
+		decode func(string) ([]byte, error)
+	}{
+		{name: "base62", encode: sx.EncodeBase62, decode: sx.DecodeBase62},
+		{name: "base58", encode: sx.EncodeBase58, decode: sx.DecodeBase58},
+		{name: "crockford", encode: sx.EncodeCrockfordBase32, decode: sx.DecodeCrockfordBase32},
+	}
+
+	inputs := [][]byte{
+		{},
+		{0},
+		{0, 0, 1},
+		[]byte("hello world"),
+		{0xff, 0xff, 0xff, 0xff},
+		bytes.Repeat([]byte{0xa5, 0x00, 0x3c}, 20),
+	}
+
+	for _, codec := range codecs {
+		for _, input := range inputs {
+			encoded := codec.encode(input)
+			decoded, err := codec.decode(encoded)
+			if err != nil {
+				t.Errorf("%s: decode(%q) error = %v", codec.name, encoded, err)
+				continue
+			}
+			if !bytes.Equal(decoded, input) {
+				t.Errorf("%s: round trip of %x = %x via %q", codec.name, input, decoded, encoded)
+			}
+		}
+	}
+}
+
+func TestBaseXKnownValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{name: "base58 hello world", got: sx.EncodeBase58([]byte("hello world")), expected: "StV1DL6CwTryKyV"},
+		{name: "base58 leading zeros", got: sx.EncodeBase58([]byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd}), expected: "11233QC4"},
+		{name: "base62 byte", got: sx.EncodeBase62([]byte{61}), expected: "z"},
+		{name: "base62 two digits", got: sx.EncodeBase62([]byte{62}), expected: "10"},
+		{name: "crockford", got: sx.EncodeCrockfordBase32([]byte("foobar")), expected: "CSQPYRK1E8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecodeCrockfordBase32_Lenient(t *testing.T) {
+	result, err := sx.DecodeCrockfordBase32("csqp-yrkl-e8")
+	if err != nil || string(result) != "foobar" {
+		t.Errorf("DecodeCrockfordBase32 = %q, %v, want %q", result, err, "foobar")
+	}
+}
+
+func TestBaseXDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		decode func(string) ([]byte, error)
+		input  string
+	}{
+		{name: "base58 zero", decode: sx.DecodeBase58, input: "abc0"},
+		{name: "base62 symbol", decode: sx.DecodeBase62, input: "ab-c"},
+		{name: "crockford U", decode: sx.DecodeCrockfordBase32, input: "UUUU"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.decode(tt.input)
+			var syntaxErr *sx.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Errorf("decode(%q) error = %v, want *sx.SyntaxError", tt.input, err)
+			}
+		})
+	}
+}
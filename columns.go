@@ -0,0 +1,115 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// Align controls horizontal alignment of text within a fixed width
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+)
+
+// ColumnOption configures how Columnize lays out rows
+type ColumnOption func(*ColumnConfig)
+
+// ColumnConfig holds the configuration for column layout
+type ColumnConfig struct {
+	// Separator is written between adjacent columns
+	Separator string
+	// Aligns holds the alignment per column; missing entries default to AlignLeft
+	Aligns []Align
+	// MaxWidths holds the maximum display width per column; 0 means unlimited
+	MaxWidths []int
+	// Ellipsis is appended to cells truncated to fit MaxWidths
+	Ellipsis string
+}
+
+// defaultColumnConfig returns the default configuration
+func defaultColumnConfig() *ColumnConfig {
+	return &ColumnConfig{
+		Separator: "  ",
+		Ellipsis:  "…",
+	}
+}
+
+// WithColumnSeparator sets the string written between columns
+func WithColumnSeparator(sep string) ColumnOption {
+	return func(c *ColumnConfig) {
+		c.Separator = sep
+	}
+}
+
+// WithAlign sets the alignment of each column, in order
+func WithAlign(aligns ...Align) ColumnOption {
+	return func(c *ColumnConfig) {
+		c.Aligns = aligns
+	}
+}
+
+// WithMaxWidths sets the maximum display width of each column, in order.
+// Use 0 for columns that should not be limited.
+func WithMaxWidths(widths ...int) ColumnOption {
+	return func(c *ColumnConfig) {
+		c.MaxWidths = widths
+	}
+}
+
+// WithEllipsis sets the marker appended to truncated cells
+func WithEllipsis(ellipsis string) ColumnOption {
+	return func(c *ColumnConfig) {
+		c.Ellipsis = ellipsis
+	}
+}
+
+// Columnize lays out rows as aligned plain-text columns, one line per row.
+// Column widths are measured in terminal cells, so wide CJK characters and
+// emoji line up. Rows may have different lengths. Trailing spaces are trimmed.
+func Columnize(rows [][]string, opts ...ColumnOption) string {
+	config := defaultColumnConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	cells := make([][]string, len(rows))
+	var widths []int
+	for i, row := range rows {
+		cells[i] = make([]string, len(row))
+		for j, cell := range row {
+			if j < len(config.MaxWidths) && config.MaxWidths[j] > 0 {
+				cell = truncateWidth(cell, config.MaxWidths[j], config.Ellipsis, false)
+			}
+			cells[i][j] = cell
+
+			if j >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[j] = max(widths[j], DisplayWidth(cell))
+		}
+	}
+
+	var result strings.Builder
+	for i, row := range cells {
+		if i > 0 {
+			result.WriteByte('\n')
+		}
+
+		var line strings.Builder
+		for j, cell := range row {
+			if j > 0 {
+				line.WriteString(config.Separator)
+			}
+			align := AlignLeft
+			if j < len(config.Aligns) {
+				align = config.Aligns[j]
+			}
+			line.WriteString(padWidth(cell, widths[j], align))
+		}
+		result.WriteString(strings.TrimRight(line.String(), " "))
+	}
+
+	return result.String()
+}
@@ -0,0 +1,110 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestColumnize(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]string
+		options  []sx.ColumnOption
+		expected string
+	}{
+		{
+			name: "default left aligned",
+			rows: [][]string{
+				{"NAME", "STATUS"},
+				{"api", "running"},
+				{"worker-long", "stopped"},
+			},
+			expected: "NAME         STATUS\n" +
+				"api          running\n" +
+				"worker-long  stopped",
+		},
+		{
+			name: "right aligned numbers",
+			rows: [][]string{
+				{"item", "qty"},
+				{"apples", "3"},
+				{"kiwis", "120"},
+			},
+			options: []sx.ColumnOption{sx.WithAlign(sx.AlignLeft, sx.AlignRight)},
+			expected: "item    qty\n" +
+				"apples    3\n" +
+				"kiwis   120",
+		},
+		{
+			name: "centered with separator",
+			rows: [][]string{
+				{"a", "b"},
+				{"long", "x"},
+			},
+			options: []sx.ColumnOption{sx.WithAlign(sx.AlignCenter), sx.WithColumnSeparator(" | ")},
+			expected: " a   | b\n" +
+				"long | x",
+		},
+		{
+			name: "wide characters",
+			rows: [][]string{
+				{"名前", "x"},
+				{"ab", "y"},
+			},
+			expected: "名前  x\n" +
+				"ab    y",
+		},
+		{
+			name: "max width truncation",
+			rows: [][]string{
+				{"description", "id"},
+				{"short", "1"},
+			},
+			options: []sx.ColumnOption{sx.WithMaxWidths(6, 0)},
+			expected: "descr…  id\n" +
+				"short   1",
+		},
+		{
+			name: "custom ellipsis",
+			rows: [][]string{
+				{"abcdefgh"},
+			},
+			options:  []sx.ColumnOption{sx.WithMaxWidths(5), sx.WithEllipsis("..")},
+			expected: "abc..",
+		},
+		{
+			name: "ellipsis wider than column",
+			rows: [][]string{
+				{"abcdefgh"},
+			},
+			options:  []sx.ColumnOption{sx.WithMaxWidths(2), sx.WithEllipsis("...")},
+			expected: "..",
+		},
+		{
+			name: "ragged rows",
+			rows: [][]string{
+				{"a", "b", "c"},
+				{"dd"},
+			},
+			expected: "a   b  c\n" +
+				"dd",
+		},
+		{
+			name:     "empty",
+			rows:     nil,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Columnize(tt.rows, tt.options...)
+			if result != tt.expected {
+				t.Errorf("Columnize() =\n%s\nwant\n%s", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package sx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Option configures splitting and case conversion. The same options are
+// accepted by SplitByCase and by every case converter.
+type Option func(*Config)
+
+// Config holds the configuration shared by SplitByCase and the case
+// converters. It can be built once with NewConfig and passed to any
+// function with WithConfig.
+type Config struct {
+	// Separators are the runes that delimit words; nil means the defaults
+	// ('-', '_', '/', '.', ' ' and '\\'), see WithSeparators
+	Separators []rune
+	// SeparatorClasses are Unicode categories whose runes also delimit words, see WithSeparatorClass
+	SeparatorClasses []*unicode.RangeTable
+	// SeparatorFunc, when set, also delimits words at the runes it reports, see WithSeparatorFunc
+	SeparatorFunc func(rune) bool
+	// If an uppercase letter is followed by other uppercase letters (like FooBAR), they are preserved. You can use sx.WithNormalize(true) for strictly following PascalCase convention.
+	Normalize bool
+	// Acronyms are words rendered in their registered spelling (like ID or URL), see WithAcronyms
+	Acronyms []string
+	// AcronymStyle controls how acronyms are rendered, see WithAcronymStyle
+	AcronymStyle AcronymStyle
+	// ProtectedSpans match regions that are kept verbatim as single words, see WithProtectedSpans
+	ProtectedSpans []SpanMatcher
+	// ProtectedDelimiters enclose regions that are kept verbatim as single words, see WithProtectedDelimiters
+	ProtectedDelimiters []Pair
+	// ProtectedWords are kept as single words in their given spelling, see WithProtectedWords
+	ProtectedWords []string
+	// Prefixes are namespace prefixes recognized at the start of the input, see WithPrefixes
+	Prefixes []string
+	// Suffixes are namespace suffixes recognized at the end of the input, see WithSuffixes
+	Suffixes []string
+	// AffixStyle controls whether known prefixes and suffixes are isolated or stripped, see WithAffixStyle
+	AffixStyle AffixStyle
+	// InvalidUTF8 controls how invalid UTF-8 in the input is handled, see WithInvalidUTF8
+	InvalidUTF8 UTF8Policy
+	// NumberStyle controls how the case converters render runs of digits, see WithNumberWordStyle
+	NumberStyle NumberStyle
+	// StopWordLang selects the stop words dropped by Abbreviate, see WithStopWordLang
+	StopWordLang string
+
+	// protected maps the protected words found while splitting the input to
+	// their rendering
+	protected map[string]string
+	// lossless receives the split of the input, see WithLossless
+	lossless *WordsInfo
+}
+
+// SplitOption configures how SplitByCase splits strings.
+//
+// Deprecated: SplitOption is an alias for Option.
+type SplitOption = Option
+
+// SplitConfig holds the configuration for splitting behavior.
+//
+// Deprecated: SplitConfig is an alias for Config.
+type SplitConfig = Config
+
+// CaseOption configures case conversion behavior.
+//
+// Deprecated: CaseOption is an alias for Option.
+type CaseOption = Option
+
+// CaseConfig configures case conversion behavior.
+//
+// Deprecated: CaseConfig is an alias for Config.
+type CaseConfig = Config
+
+// UTF8Policy controls how invalid UTF-8 in the input is handled
+type UTF8Policy int
+
+const (
+	// UTF8Replace replaces each invalid byte with U+FFFD
+	UTF8Replace UTF8Policy = iota
+	// UTF8Preserve passes invalid bytes through unchanged
+	UTF8Preserve
+	// UTF8Drop removes invalid bytes
+	UTF8Drop
+)
+
+// NewConfig returns the configuration built from opts on top of the package
+// defaults, or an error wrapping ErrInvalidConfig if it is not valid
+//
+// Example:
+//
+//	config, err := NewConfig(WithAcronyms("ID", "URL"), WithNormalize(true))
+//	PascalCase("user_id", WithConfig(config)) // UserID
+func NewConfig(opts ...Option) (*Config, error) {
+	config := newConfig(opts)
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// newConfig returns the configuration built from opts on top of the package
+// defaults without validating it
+func newConfig(opts []Option) *Config {
+	config := &Config{}
+	if d := defaults.Load(); d != nil {
+		WithConfig(d)(config)
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// defaults holds the configuration set with SetDefaults, nil until then
+var defaults atomic.Pointer[Config]
+
+// emptyConfig is the configuration used when there are neither options nor
+// defaults. It is never modified.
+var emptyConfig = &Config{}
+
+// SetDefaults sets options applied by every function taking Options before
+// the options of the call, so they need not be repeated at every call site.
+// Each call replaces the defaults set by the previous one; SetDefaults()
+// clears them. Options of a call add to or override the defaults, and
+// WithConfig replaces them. It is meant to be called during initialization,
+// but is safe for concurrent use.
+//
+// Functions whose results serve as keys ignore the defaults, so the results
+// do not change with them: the keys of Map, WordHash, NewSlug, MatchFields,
+// Abbreviate and ReverseWords with WithCaseSplit.
+//
+// Example:
+//
+//	func init() {
+//		sx.SetDefaults(sx.WithAcronyms("ID", "URL", "API"), sx.WithNormalize(true))
+//	}
+func SetDefaults(opts ...Option) {
+	config := &Config{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	defaults.Store(config)
+}
+
+// configFor returns the configuration built from opts, sharing the defaults
+// when there are no options so that option-free calls do not allocate
+func configFor(opts []Option) *Config {
+	if len(opts) > 0 {
+		return newConfig(opts)
+	}
+	d := defaults.Load()
+	if d == nil {
+		return emptyConfig
+	}
+	return d.instance()
+}
+
+// instance returns a configuration for a single call: c itself, unless
+// splitting with it records the protected words found, in which case a copy
+// so that calls sharing c do not race
+func (c *Config) instance() *Config {
+	if len(c.ProtectedSpans) == 0 && len(c.ProtectedDelimiters) == 0 && len(c.ProtectedWords) == 0 {
+		return c
+	}
+	config := &Config{}
+	WithConfig(c)(config)
+	return config
+}
+
+// Validate reports the first problem with the configuration as an error
+// wrapping ErrInvalidConfig: separators that are letters or digits, nil
+// separator classes, empty protected words, empty prefixes or suffixes, empty
+// acronyms or acronyms containing other characters, empty protected
+// delimiters, nil protected span matchers, or unknown styles and policies
+func (c *Config) Validate() error {
+	for _, r := range c.Separators {
+		if isLetter(r) || isDigit(r) {
+			return fmt.Errorf("%w: separator %q is a letter or digit", ErrInvalidConfig, r)
+		}
+	}
+	for _, a := range c.Acronyms {
+		if a == "" || strings.IndexFunc(a, func(r rune) bool { return !isLetterOrDigit(r) }) >= 0 {
+			return fmt.Errorf("%w: acronym %q must consist of letters and digits", ErrInvalidConfig, a)
+		}
+	}
+	if c.AcronymStyle < AcronymDefault || c.AcronymStyle > AcronymUpper {
+		return fmt.Errorf("%w: unknown acronym style %d", ErrInvalidConfig, c.AcronymStyle)
+	}
+	for _, class := range c.SeparatorClasses {
+		if class == nil {
+			return fmt.Errorf("%w: nil separator class", ErrInvalidConfig)
+		}
+	}
+	for _, m := range c.ProtectedSpans {
+		if m == nil {
+			return fmt.Errorf("%w: nil protected span matcher", ErrInvalidConfig)
+		}
+	}
+	for _, word := range c.ProtectedWords {
+		if word == "" {
+			return fmt.Errorf("%w: empty protected word", ErrInvalidConfig)
+		}
+	}
+	for _, p := range c.ProtectedDelimiters {
+		if p.Open == "" || p.Close == "" {
+			return fmt.Errorf("%w: empty protected delimiter in %q", ErrInvalidConfig, p)
+		}
+	}
+	for _, affix := range slices.Concat(c.Prefixes, c.Suffixes) {
+		if affix == "" {
+			return fmt.Errorf("%w: empty prefix or suffix", ErrInvalidConfig)
+		}
+	}
+	if c.AffixStyle < AffixIsolate || c.AffixStyle > AffixStrip {
+		return fmt.Errorf("%w: unknown affix style %d", ErrInvalidConfig, c.AffixStyle)
+	}
+	if c.NumberStyle < NumberAttached || c.NumberStyle > NumberSpelled {
+		return fmt.Errorf("%w: unknown number style %d", ErrInvalidConfig, c.NumberStyle)
+	}
+	if c.InvalidUTF8 < UTF8Replace || c.InvalidUTF8 > UTF8Drop {
+		return fmt.Errorf("%w: unknown UTF-8 policy %d", ErrInvalidConfig, c.InvalidUTF8)
+	}
+	return nil
+}
+
+// WithConfig applies every setting of config. Options given after it add to
+// or override those settings; config itself is never modified. A nil config
+// has no effect.
+func WithConfig(config *Config) Option {
+	return func(c *Config) {
+		if config == nil {
+			return
+		}
+		*c = *config
+		// Clip the slices so appending options cannot write into config's arrays
+		c.Separators = slices.Clip(c.Separators)
+		c.Acronyms = slices.Clip(c.Acronyms)
+		c.ProtectedSpans = slices.Clip(c.ProtectedSpans)
+		c.ProtectedDelimiters = slices.Clip(c.ProtectedDelimiters)
+		c.ProtectedWords = slices.Clip(c.ProtectedWords)
+		c.Prefixes = slices.Clip(c.Prefixes)
+		c.Suffixes = slices.Clip(c.Suffixes)
+		c.protected = nil
+	}
+}
+
+// WithSeparators sets custom separator runes (replaces defaults)
+func WithSeparators(separators ...rune) Option {
+	return func(c *Config) {
+		c.Separators = make([]rune, len(separators))
+		copy(c.Separators, separators)
+	}
+}
+
+// WithSeparatorClass makes every rune of the given Unicode categories a
+// separator, in addition to the separator runes, so punctuation such as
+// '·', '•' and '—' need not be listed one by one:
+//
+//	SplitByCase("foo·bar—baz", WithSeparatorClass(unicode.Punct)) // [foo bar baz]
+func WithSeparatorClass(classes ...*unicode.RangeTable) Option {
+	return func(c *Config) {
+		c.SeparatorClasses = append(c.SeparatorClasses, classes...)
+	}
+}
+
+// WithSeparatorFunc makes every rune for which fn returns true a separator,
+// in addition to the separator runes
+func WithSeparatorFunc(fn func(rune) bool) Option {
+	return func(c *Config) {
+		c.SeparatorFunc = fn
+	}
+}
+
+// isSeparator reports whether r delimits words
+func (c *Config) isSeparator(r rune) bool {
+	if c.Separators != nil {
+		// Custom separators specified - only split on those (could be empty list)
+		if isSeparatorCustom(r, c.Separators) {
+			return true
+		}
+	} else if isSeparator(r) {
+		return true
+	}
+	if len(c.SeparatorClasses) > 0 && unicode.IsOneOf(c.SeparatorClasses, r) {
+		return true
+	}
+	return c.SeparatorFunc != nil && c.SeparatorFunc(r)
+}
+
+// WithNormalize sets the normalize option
+func WithNormalize(normalize bool) Option {
+	return func(c *Config) {
+		c.Normalize = normalize
+	}
+}
+
+// WithInvalidUTF8 sets how invalid UTF-8 in the input is handled (default UTF8Replace)
+func WithInvalidUTF8(policy UTF8Policy) Option {
+	return func(c *Config) {
+		c.InvalidUTF8 = policy
+	}
+}
+
+// sanitize applies the UTF-8 policy to s
+func (c *Config) sanitize(s string) string {
+	switch c.InvalidUTF8 {
+	case UTF8Preserve:
+		return s
+	case UTF8Drop:
+		return strings.ToValidUTF8(s, "")
+	}
+	if utf8.ValidString(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	for _, r := range s {
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// inputWords returns the words of input: a string is split, while the
+// elements of a slice are taken as words as they are, and those that are
+// protected as a whole are kept verbatim
+func (c *Config) inputWords(input any) []string {
+	switch v := input.(type) {
+	case string:
+		return c.renderNumbers(c.split(v))
+	case Words:
+		return c.inputWords([]string(v))
+	case []string:
+		if c.InvalidUTF8 == UTF8Preserve {
+			c.protectWords(v)
+			return c.renderNumbers(v)
+		}
+
+		words := make([]string, len(v))
+		for i, word := range v {
+			words[i] = c.sanitize(word)
+		}
+		c.protectWords(words)
+		return c.renderNumbers(words)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestKeysIgnoreDefaults(t *testing.T) {
+	m := sx.NewMap[int]()
+	m.Set("api_v2", 1)
+	m.Set("ios_app", 2)
+
+	tests := []struct {
+		name string
+		call func() any
+	}{
+		{
+			name: "Map",
+			call: func() any {
+				var found []bool
+				for _, key := range []string{"api_v2", "ApiV2", "ios_app", "IosApp"} {
+					_, ok := m.Get(key)
+					found = append(found, ok)
+				}
+				return found
+			},
+		},
+		{name: "WordHash", call: func() any { return sx.WordHash("iOS_app.v2") }},
+		{
+			name: "NewSlug",
+			call: func() any {
+				slug, err := sx.NewSlug("iOS App 2")
+				return []any{slug, err}
+			},
+		},
+		{
+			name: "MatchFields",
+			call: func() any {
+				matches, unmatched := sx.MatchFields([]string{"x_y", "address_2"}, []string{"XY", "Address2"})
+				return []any{matches, unmatched}
+			},
+		},
+		{name: "Abbreviate", call: func() any { return sx.Abbreviate("customerAccountNumber2", 12) }},
+		{name: "ReverseWords", call: func() any { return sx.ReverseWords("foo_barBaz", sx.WithCaseSplit()) }},
+	}
+
+	expected := make([]any, len(tests))
+	for i, tt := range tests {
+		expected[i] = tt.call()
+	}
+
+	sx.SetDefaults(sx.WithNumberWordStyle(sx.NumberSpelled), sx.WithProtectedWords("iOS"), sx.WithSeparators('.'))
+	t.Cleanup(func() { sx.SetDefaults() })
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.call(); !reflect.DeepEqual(result, expected[i]) {
+				t.Errorf("%s = %v with defaults set, want %v", tt.name, result, expected[i])
+			}
+		})
+	}
+}
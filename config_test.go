@@ -0,0 +1,134 @@
+package sx_test
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+
+	"github.com/gomantics/sx"
+)
+
+func TestNewConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []sx.Option
+		wantErr bool
+	}{
+		{name: "empty", options: nil},
+		{name: "all options", options: []sx.Option{
+			sx.WithSeparators('_', '-'), sx.WithNormalize(true), sx.WithAcronyms("ID", "UTF8"),
+			sx.WithAcronymStyle(sx.AcronymUpper), sx.WithProtectedDelimiters(sx.Pair{"{", "}"}),
+			sx.WithInvalidUTF8(sx.UTF8Replace),
+		}},
+		{name: "letter separator", options: []sx.Option{sx.WithSeparators('x')}, wantErr: true},
+		{name: "digit separator", options: []sx.Option{sx.WithSeparators('1')}, wantErr: true},
+		{name: "empty acronym", options: []sx.Option{sx.WithAcronyms("")}, wantErr: true},
+		{name: "acronym with punctuation", options: []sx.Option{sx.WithAcronyms("I/O")}, wantErr: true},
+		{name: "unknown acronym style", options: []sx.Option{sx.WithAcronymStyle(sx.AcronymStyle(42))}, wantErr: true},
+		{name: "nil separator class", options: []sx.Option{sx.WithSeparatorClass(nil)}, wantErr: true},
+		{name: "nil span matcher", options: []sx.Option{sx.WithProtectedSpans(nil)}, wantErr: true},
+		{name: "empty protected word", options: []sx.Option{sx.WithProtectedWords("")}, wantErr: true},
+		{name: "empty delimiter", options: []sx.Option{sx.WithProtectedDelimiters(sx.Pair{"{", ""})}, wantErr: true},
+		{name: "empty prefix", options: []sx.Option{sx.WithPrefixes("tbl_", "")}, wantErr: true},
+		{name: "unknown affix style", options: []sx.Option{sx.WithAffixStyle(sx.AffixStyle(2))}, wantErr: true},
+		{name: "unknown number style", options: []sx.Option{sx.WithNumberWordStyle(sx.NumberStyle(3))}, wantErr: true},
+		{name: "unknown utf-8 policy", options: []sx.Option{sx.WithInvalidUTF8(sx.UTF8Policy(-1))}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := sx.NewConfig(tt.options...)
+			if tt.wantErr {
+				if !errors.Is(err, sx.ErrInvalidConfig) {
+					t.Errorf("NewConfig() error = %v, want ErrInvalidConfig", err)
+				}
+				return
+			}
+			if err != nil || config == nil {
+				t.Fatalf("NewConfig() = %v, %v", config, err)
+			}
+		})
+	}
+}
+
+func TestWithConfig(t *testing.T) {
+	config, err := sx.NewConfig(sx.WithAcronyms("ID"), sx.WithSeparators('.'))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		convert  func(string, ...sx.Option) string
+		input    string
+		options  []sx.Option
+		expected string
+	}{
+		{name: "pascal", convert: sx.PascalCase[string], input: "user.id", expected: "UserID"},
+		{name: "camel", convert: sx.CamelCase[string], input: "user.id", expected: "userID"},
+		{name: "train", convert: sx.TrainCase[string], input: "user.id", expected: "User-ID"},
+		{name: "separators replace defaults", convert: sx.SnakeCase[string], input: "a.b_c", expected: "a_b_c"},
+		{name: "later options extend", convert: sx.PascalCase[string], input: "user.url", options: []sx.Option{sx.WithAcronyms("URL")}, expected: "UserURL"},
+		{name: "later options override", convert: sx.SnakeCase[string], input: "a.b_c", options: []sx.Option{sx.WithSeparators('_')}, expected: "a.b_c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := append([]sx.Option{sx.WithConfig(config)}, tt.options...)
+			if result := tt.convert(tt.input, options...); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	if len(config.Acronyms) != 1 || len(config.Separators) != 1 {
+		t.Errorf("config modified by later options: %+v", config)
+	}
+}
+
+func TestSplitByCaseOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.Option
+		expected []string
+	}{
+		{name: "protected span", input: "get{userId}ById", options: []sx.Option{sx.WithProtectedDelimiters(sx.Pair{"{", "}"})}, expected: []string{"get", "{userId}", "By", "Id"}},
+		{name: "invalid utf-8 replaced", input: "foo\xff\xfe_bar", expected: []string{"foo\uFFFD\uFFFD", "bar"}},
+		{name: "invalid utf-8 kept", input: "foo\xff_bar", options: []sx.Option{sx.WithInvalidUTF8(sx.UTF8Preserve)}, expected: []string{"foo\xff", "bar"}},
+		{name: "punctuation class", input: "foo\u00b7bar\u2014baz\u2022Qux", options: []sx.Option{sx.WithSeparatorClass(unicode.Punct)}, expected: []string{"foo", "bar", "baz", "Qux"}},
+		{name: "symbol class with defaults", input: "a+b_c", options: []sx.Option{sx.WithSeparatorClass(unicode.Symbol)}, expected: []string{"a", "b", "c"}},
+		{name: "class with custom separators", input: "a+b_c.d", options: []sx.Option{sx.WithSeparators('.'), sx.WithSeparatorClass(unicode.Symbol)}, expected: []string{"a", "b_c", "d"}},
+		{name: "separator func", input: "a1b2c", options: []sx.Option{sx.WithSeparatorFunc(func(r rune) bool { return r == '1' || r == '2' })}, expected: []string{"a", "b", "c"}},
+		{name: "invalid utf-8 dropped", input: "foo\xffBar", options: []sx.Option{sx.WithInvalidUTF8(sx.UTF8Drop)}, expected: []string{"foo", "Bar"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.SplitByCase(tt.input, tt.options...)
+			if len(result) != len(tt.expected) {
+				t.Fatalf("SplitByCase(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+			for i := range result {
+				if result[i] != tt.expected[i] {
+					t.Errorf("SplitByCase(%q) = %q, want %q", tt.input, result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSeparatorClassConversion(t *testing.T) {
+	result := sx.SnakeCase("Caf\u00e9\u00b7Menu\u2014Items", sx.WithSeparatorClass(unicode.Punct))
+	if result != "caf\u00e9_menu_items" {
+		t.Errorf("SnakeCase = %q, want %q", result, "caf\u00e9_menu_items")
+	}
+}
+
+func TestInvalidUTF8Slice(t *testing.T) {
+	result := sx.SnakeCase([]string{"foo\xff", "Bar"}, sx.WithInvalidUTF8(sx.UTF8Drop))
+	if result != "foo_bar" {
+		t.Errorf("SnakeCase = %q, want %q", result, "foo_bar")
+	}
+}
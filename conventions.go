@@ -0,0 +1,87 @@
+package sx
+
+import "slices"
+
+// ConventionReport summarizes the naming conventions used across a set of identifiers
+type ConventionReport struct {
+	// Total is the number of identifiers analyzed
+	Total int
+	// Counts holds how many identifiers were detected in each style.
+	// Every identifier is counted once, under its most specific style.
+	Counts map[CaseStyle]int
+	// Dominant is the style the largest number of identifiers conform to.
+	// Single lowercase words conform to camel, snake and kebab case alike,
+	// so they support whichever of those the rest of the corpus uses.
+	Dominant CaseStyle
+	// Outliers lists the identifiers that do not conform to Dominant, in input order
+	Outliers []string
+}
+
+// Fraction returns the share of identifiers detected in the given style
+func (r ConventionReport) Fraction(style CaseStyle) float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return float64(r.Counts[style]) / float64(r.Total)
+}
+
+// AnalyzeConventions detects the case style of every token and reports the
+// distribution of styles, the dominant convention and the tokens that break it.
+// Empty tokens are ignored.
+func AnalyzeConventions(tokens []string) ConventionReport {
+	report := ConventionReport{Counts: make(map[CaseStyle]int)}
+
+	conforming := make(map[CaseStyle]int)
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		report.Total++
+		report.Counts[DetectCase(token)]++
+		for _, style := range caseStyles {
+			if conformsTo(token, style) {
+				conforming[style]++
+			}
+		}
+	}
+
+	if report.Total == 0 {
+		return report
+	}
+
+	// Prefer multi-word conventions over flatcase when they explain as many tokens
+	report.Dominant = StyleMixed
+	best := 0
+	for _, style := range slices.Concat(caseStyles[1:], []CaseStyle{StyleFlat}) {
+		if conforming[style] > best {
+			report.Dominant, best = style, conforming[style]
+		}
+	}
+
+	for _, token := range tokens {
+		if token != "" && (report.Dominant == StyleMixed || !conformsTo(token, report.Dominant)) {
+			report.Outliers = append(report.Outliers, token)
+		}
+	}
+
+	return report
+}
+
+// DetectCaseAll buckets tokens by their detected case style in a single pass,
+// keeping input order within each bucket. Empty tokens are ignored.
+//
+// Example:
+//
+//	DetectCaseAll([]string{"user_id", "userName", "created_at"})
+//	// map[snake_case:[user_id created_at] camelCase:[userName]]
+func DetectCaseAll(tokens []string) map[CaseStyle][]string {
+	buckets := make(map[CaseStyle][]string)
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		style := DetectCase(token)
+		buckets[style] = append(buckets[style], token)
+	}
+	return buckets
+}
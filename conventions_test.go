@@ -0,0 +1,127 @@
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestAnalyzeConventions(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokens   []string
+		dominant sx.CaseStyle
+		outliers []string
+		counts   map[sx.CaseStyle]int
+	}{
+		{
+			name:     "snake corpus",
+			tokens:   []string{"user_id", "created_at", "name", "updatedAt", "EMAIL"},
+			dominant: sx.StyleSnake,
+			outliers: []string{"updatedAt", "EMAIL"},
+			counts: map[sx.CaseStyle]int{
+				sx.StyleSnake:          2,
+				sx.StyleFlat:           1,
+				sx.StyleCamel:          1,
+				sx.StyleScreamingSnake: 1,
+			},
+		},
+		{
+			name:     "go exported names",
+			tokens:   []string{"UserID", "NewServer", "Handler", "user_name", "X"},
+			dominant: sx.StylePascal,
+			outliers: []string{"user_name"},
+			counts: map[sx.CaseStyle]int{
+				sx.StylePascal: 4,
+				sx.StyleSnake:  1,
+			},
+		},
+		{
+			name:     "kebab and train",
+			tokens:   []string{"content-type", "x-request-id", "Content-Length", "accept"},
+			dominant: sx.StyleKebab,
+			outliers: []string{"Content-Length"},
+			counts: map[sx.CaseStyle]int{
+				sx.StyleKebab: 2,
+				sx.StyleTrain: 1,
+				sx.StyleFlat:  1,
+			},
+		},
+		{
+			name:     "mixed",
+			tokens:   []string{"user_Id", "Foo-bar", ""},
+			dominant: sx.StyleMixed,
+			outliers: []string{"user_Id", "Foo-bar"},
+			counts: map[sx.CaseStyle]int{
+				sx.StyleMixed: 2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := sx.AnalyzeConventions(tt.tokens)
+			if report.Dominant != tt.dominant {
+				t.Errorf("Dominant = %v, want %v", report.Dominant, tt.dominant)
+			}
+			if !reflect.DeepEqual(report.Outliers, tt.outliers) {
+				t.Errorf("Outliers = %q, want %q", report.Outliers, tt.outliers)
+			}
+			if !reflect.DeepEqual(report.Counts, tt.counts) {
+				t.Errorf("Counts = %v, want %v", report.Counts, tt.counts)
+			}
+		})
+	}
+}
+
+func TestConventionReport_Fraction(t *testing.T) {
+	report := sx.AnalyzeConventions([]string{"a_b", "c_d", "eF", "g_h"})
+	if got := report.Fraction(sx.StyleSnake); got != 0.75 {
+		t.Errorf("Fraction(StyleSnake) = %v, want 0.75", got)
+	}
+	if got := sx.AnalyzeConventions(nil).Fraction(sx.StyleSnake); got != 0 {
+		t.Errorf("Fraction on empty report = %v, want 0", got)
+	}
+}
+
+func TestDetectCaseAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected map[sx.CaseStyle][]string
+	}{
+		{
+			name:     "empty",
+			input:    nil,
+			expected: map[sx.CaseStyle][]string{},
+		},
+		{
+			name:  "mixed corpus",
+			input: []string{"user_id", "userName", "created_at", "ID", "HTTPServer", "x-request-id", "id", "Foo_bar", ""},
+			expected: map[sx.CaseStyle][]string{
+				sx.StyleSnake:          {"user_id", "created_at"},
+				sx.StyleCamel:          {"userName"},
+				sx.StyleScreamingSnake: {"ID"},
+				sx.StylePascal:         {"HTTPServer"},
+				sx.StyleKebab:          {"x-request-id"},
+				sx.StyleFlat:           {"id"},
+				sx.StyleMixed:          {"Foo_bar"},
+			},
+		},
+		{
+			name:     "duplicates kept",
+			input:    []string{"a_b", "a_b"},
+			expected: map[sx.CaseStyle][]string{sx.StyleSnake: {"a_b", "a_b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.DetectCaseAll(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("DetectCaseAll(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
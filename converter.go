@@ -0,0 +1,92 @@
+package sx
+
+import "slices"
+
+// Converter converts strings with a fixed configuration, see NewConverter
+// and DefaultConverter
+type Converter struct {
+	config *Config
+}
+
+// NewConverter returns a Converter using opts on top of the package
+// defaults. The configuration is built once, so code generators converting
+// many names with the same options neither repeat nor rebuild them. A
+// Converter is safe for concurrent use.
+//
+// Example:
+//
+//	conv := sx.NewConverter(sx.WithAcronyms(sx.GoInitialisms...), sx.WithNormalize(true))
+//	conv.Pascal("user_id") // UserID
+//	conv.Camel("API_URL")  // apiURL
+func NewConverter(opts ...Option) Converter {
+	return Converter{config: newConfig(opts)}
+}
+
+// DefaultConverter returns a Converter using the package defaults set with
+// SetDefaults. Later calls to SetDefaults do not affect it.
+//
+// Example:
+//
+//	sx.SetDefaults(sx.WithAcronyms("ID"))
+//	sx.DefaultConverter().Pascal("user_id") // UserID
+func DefaultConverter() Converter {
+	if d := defaults.Load(); d != nil {
+		return Converter{config: d}
+	}
+	return Converter{config: emptyConfig}
+}
+
+// Config returns a copy of the configuration of the Converter
+func (c Converter) Config() *Config {
+	config := *c.config
+	config.Separators = slices.Clone(config.Separators)
+	config.SeparatorClasses = slices.Clone(config.SeparatorClasses)
+	config.Acronyms = slices.Clone(config.Acronyms)
+	config.ProtectedSpans = slices.Clone(config.ProtectedSpans)
+	config.ProtectedDelimiters = slices.Clone(config.ProtectedDelimiters)
+	config.ProtectedWords = slices.Clone(config.ProtectedWords)
+	config.Prefixes = slices.Clone(config.Prefixes)
+	config.Suffixes = slices.Clone(config.Suffixes)
+	config.protected = nil
+	return &config
+}
+
+// Split splits s into words, see SplitByCase
+func (c Converter) Split(s string) Words {
+	return c.config.instance().split(s)
+}
+
+// Pascal converts s to PascalCase, see PascalCase
+func (c Converter) Pascal(s string) string {
+	return c.config.instance().pascalCase(s)
+}
+
+// Camel converts s to camelCase, see CamelCase
+func (c Converter) Camel(s string) string {
+	return c.config.instance().camelCase(s)
+}
+
+// Kebab converts s to kebab-case, see KebabCase
+func (c Converter) Kebab(s string) string {
+	return c.config.instance().delimitedCase(s, "-")
+}
+
+// Snake converts s to snake_case, see SnakeCase
+func (c Converter) Snake(s string) string {
+	return c.config.instance().delimitedCase(s, "_")
+}
+
+// Space converts s to lowercase words separated by spaces, see SpaceCase
+func (c Converter) Space(s string) string {
+	return c.config.instance().delimitedCase(s, " ")
+}
+
+// Train converts s to Train-Case, see TrainCase
+func (c Converter) Train(s string) string {
+	return c.config.instance().trainCase(s)
+}
+
+// Flat converts s to flatcase, see FlatCase
+func (c Converter) Flat(s string) string {
+	return c.config.instance().delimitedCase(s, "")
+}
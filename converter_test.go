@@ -0,0 +1,135 @@
+package sx_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSetDefaults(t *testing.T) {
+	sx.SetDefaults(sx.WithAcronyms("ID", "URL"), sx.WithSeparators('_', '.'))
+	t.Cleanup(func() { sx.SetDefaults() })
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{name: "defaults applied", result: sx.PascalCase("user_id"), expected: "UserID"},
+		{name: "defaults with options", result: sx.CamelCase("user_url", sx.WithNormalize(true)), expected: "userURL"},
+		{name: "options extend defaults", result: sx.PascalCase("api_id", sx.WithAcronyms("API")), expected: "APIID"},
+		{name: "options override defaults", result: sx.SnakeCase("a-b.c", sx.WithSeparators('-')), expected: "a_b.c"},
+		{name: "config replaces defaults", result: sx.PascalCase("user_id", sx.WithConfig(&sx.Config{})), expected: "UserId"},
+		{name: "default separators", result: sx.KebabCase("a-b.c"), expected: "a-b-c"},
+		{name: "split", result: sx.SplitByCase("a.b").ToSnake(), expected: "a_b"},
+		{name: "converter", result: sx.DefaultConverter().Train("user_id"), expected: "User-ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+
+	conv := sx.DefaultConverter()
+	sx.SetDefaults()
+	if result := sx.PascalCase("user_id"); result != "UserId" {
+		t.Errorf("after clearing defaults PascalCase = %q, want %q", result, "UserId")
+	}
+	if result := conv.Pascal("user_id"); result != "UserID" {
+		t.Errorf("Converter after clearing defaults = %q, want %q", result, "UserID")
+	}
+}
+
+func TestDefaultConverter(t *testing.T) {
+	sx.SetDefaults(sx.WithProtectedWords("gRPC"))
+	t.Cleanup(func() { sx.SetDefaults() })
+	conv := sx.DefaultConverter()
+
+	tests := []struct {
+		name     string
+		convert  func(string) string
+		expected string
+	}{
+		{name: "pascal", convert: conv.Pascal, expected: "gRPCClientId"},
+		{name: "camel", convert: conv.Camel, expected: "gRPCClientId"},
+		{name: "kebab", convert: conv.Kebab, expected: "gRPC-client-id"},
+		{name: "snake", convert: conv.Snake, expected: "gRPC_client_id"},
+		{name: "train", convert: conv.Train, expected: "gRPC-Client-Id"},
+		{name: "flat", convert: conv.Flat, expected: "gRPCclientid"},
+		{name: "space", convert: conv.Space, expected: "gRPC client id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.convert("grpc_client_id"); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	config := conv.Config()
+	config.ProtectedWords[0] = "GRPC"
+	if words := conv.Split("grpcClient"); len(words) != 2 || words[0] != "grpc" {
+		t.Errorf("Split = %q, want [grpc Client]", words)
+	}
+
+	// Protected words are recorded while splitting, so shared defaults must
+	// not be written to by concurrent calls
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 100 {
+				if result := sx.SnakeCase("grpcClient"); result != "gRPC_client" {
+					t.Errorf("SnakeCase = %q, want %q", result, "gRPC_client")
+					return
+				}
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestNewConverter(t *testing.T) {
+	conv := sx.NewConverter(sx.WithAcronyms(sx.GoInitialisms...), sx.WithNormalize(true), sx.WithSeparators('_', '-'))
+
+	tests := []struct {
+		name     string
+		convert  func(string) string
+		input    string
+		expected string
+	}{
+		{name: "pascal", convert: conv.Pascal, input: "user_id", expected: "UserID"},
+		{name: "camel", convert: conv.Camel, input: "API_URL", expected: "apiURL"},
+		{name: "snake", convert: conv.Snake, input: "HTTPServer-config", expected: "http_server_config"},
+		{name: "kebab", convert: conv.Kebab, input: "userID", expected: "user-id"},
+		{name: "train", convert: conv.Train, input: "json_api", expected: "JSON-API"},
+		{name: "flat", convert: conv.Flat, input: "Foo-Bar", expected: "foobar"},
+		{name: "separators", convert: conv.Snake, input: "a.b", expected: "a.b"},
+		{name: "split", convert: func(s string) string { return strings.Join(conv.Split(s), " ") }, input: "xmlHTTPRequest", expected: "xml HTTP Request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.convert(tt.input); result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	// Options build on the defaults at construction time only
+	sx.SetDefaults(sx.WithAcronyms("DB"))
+	t.Cleanup(func() { sx.SetDefaults() })
+	withDefaults := sx.NewConverter()
+	sx.SetDefaults()
+	if result := withDefaults.Pascal("db_name"); result != "DBName" {
+		t.Errorf("Pascal = %q, want %q", result, "DBName")
+	}
+	if result := conv.Pascal("db_name"); result != "DbName" {
+		t.Errorf("Pascal = %q, want %q", result, "DbName")
+	}
+}
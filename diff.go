@@ -0,0 +1,350 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EditOp is the kind of change an Edit describes
+type EditOp int
+
+const (
+	// EditEqual is a line present in both texts
+	EditEqual EditOp = iota
+	// EditInsert is a line present only in the new text
+	EditInsert
+	// EditDelete is a line present only in the old text
+	EditDelete
+)
+
+// String returns the name of the operation
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// prefix returns the unified diff line prefix of the operation
+func (op EditOp) prefix() byte {
+	switch op {
+	case EditInsert:
+		return '+'
+	case EditDelete:
+		return '-'
+	default:
+		return ' '
+	}
+}
+
+// Edit is one line of a line-level diff
+type Edit struct {
+	Op EditOp
+	// OldLine and NewLine are the zero-based positions of the line in the old
+	// and new text. An insertion has no old line; OldLine is the position of
+	// the old line it is inserted before, and likewise NewLine for deletions.
+	OldLine, NewLine int
+	// Text is the line including its trailing newline, if any
+	Text string
+}
+
+// DiffLines returns a shortest edit script turning a into b, one Edit per
+// line of either text. Within each changed region deletions come before
+// insertions. It uses Myers' O(ND) algorithm, so it is fast for similar
+// texts and degrades gracefully as they diverge.
+//
+// Example:
+//
+//	DiffLines("a\nb\n", "a\nc\n")
+//	// [{equal 0 0 "a\n"} {delete 1 1 "b\n"} {insert 2 1 "c\n"}]
+func DiffLines(a, b string) []Edit {
+	oldLines, newLines := splitLines(a), splitLines(b)
+
+	// Compare interned line numbers rather than strings
+	ids := make(map[string]int)
+	intern := func(lines []string) []int {
+		out := make([]int, len(lines))
+		for i, line := range lines {
+			id, ok := ids[line]
+			if !ok {
+				id = len(ids)
+				ids[line] = id
+			}
+			out[i] = id
+		}
+		return out
+	}
+	x, y := intern(oldLines), intern(newLines)
+
+	// Common prefix and suffix need no search
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]EditOp, 0, len(x)+len(y))
+	for range prefix {
+		ops = append(ops, EditEqual)
+	}
+	ops = append(ops, myersDiff(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])...)
+	for range suffix {
+		ops = append(ops, EditEqual)
+	}
+
+	return buildEdits(ops, oldLines, newLines)
+}
+
+// splitLines splits s after each newline; a final line without one is kept
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// myersDiff returns the operations of a shortest edit script turning a into b
+func myersDiff(a, b []int) []EditOp {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		ops := slices.Repeat([]EditOp{EditDelete}, n)
+		return append(ops, slices.Repeat([]EditOp{EditInsert}, m)...)
+	}
+
+	// v[offset+k] is the furthest x reached on diagonal k = x-y; trace keeps
+	// the part of v in use before each step for backtracking
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	var trace [][]int
+	for d := 0; ; d++ {
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[offset+k-1] < v[offset+k+1] {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackMyers(trace, n, m)
+			}
+		}
+	}
+}
+
+// backtrackMyers recovers the edit script from the trace of myersDiff
+func backtrackMyers(trace [][]int, n, m int) []EditOp {
+	var ops []EditOp
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || k != d && v[d+k-1] < v[d+k+1] {
+			prevK = k + 1
+		}
+		prevX := v[d+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, EditEqual)
+			x--
+			y--
+		}
+		if x == prevX {
+			ops = append(ops, EditInsert)
+		} else {
+			ops = append(ops, EditDelete)
+		}
+		x, y = prevX, prevY
+	}
+	for ; x > 0; x-- {
+		ops = append(ops, EditEqual)
+	}
+
+	slices.Reverse(ops)
+	return ops
+}
+
+// buildEdits turns operations into Edits, moving the deletions of each
+// changed region before its insertions
+func buildEdits(ops []EditOp, oldLines, newLines []string) []Edit {
+	edits := make([]Edit, 0, len(ops))
+	oldPos, newPos := 0, 0
+	for i := 0; i < len(ops); {
+		if ops[i] == EditEqual {
+			edits = append(edits, Edit{Op: EditEqual, OldLine: oldPos, NewLine: newPos, Text: oldLines[oldPos]})
+			oldPos++
+			newPos++
+			i++
+			continue
+		}
+
+		deletes, inserts := 0, 0
+		for ; i < len(ops) && ops[i] != EditEqual; i++ {
+			if ops[i] == EditDelete {
+				deletes++
+			} else {
+				inserts++
+			}
+		}
+		for j := range deletes {
+			edits = append(edits, Edit{Op: EditDelete, OldLine: oldPos + j, NewLine: newPos, Text: oldLines[oldPos+j]})
+		}
+		oldPos += deletes
+		for j := range inserts {
+			edits = append(edits, Edit{Op: EditInsert, OldLine: oldPos, NewLine: newPos + j, Text: newLines[newPos+j]})
+		}
+		newPos += inserts
+	}
+	return edits
+}
+
+// DiffOption configures UnifiedDiff
+type DiffOption func(*DiffConfig)
+
+// DiffConfig holds the configuration for UnifiedDiff
+type DiffConfig struct {
+	// Context is the number of unchanged lines shown around each change
+	Context int
+	// OldName and NewName label the texts in the ---/+++ header
+	OldName, NewName string
+}
+
+// defaultDiffConfig returns the default configuration for UnifiedDiff
+func defaultDiffConfig() *DiffConfig {
+	return &DiffConfig{
+		Context: 3,
+		OldName: "a",
+		NewName: "b",
+	}
+}
+
+// WithContext sets the number of unchanged lines shown around each change (default 3)
+func WithContext(lines int) DiffOption {
+	return func(c *DiffConfig) {
+		c.Context = max(lines, 0)
+	}
+}
+
+// WithFileNames sets the names shown in the ---/+++ header (default "a" and "b")
+func WithFileNames(oldName, newName string) DiffOption {
+	return func(c *DiffConfig) {
+		c.OldName = oldName
+		c.NewName = newName
+	}
+}
+
+// UnifiedDiff returns the differences between a and b in unified diff
+// format, with ---/+++ headers and @@ hunks. It returns an empty string when
+// the texts are equal. Lines lacking a final newline are marked with
+// "\ No newline at end of file", as in diff and git.
+//
+// Example:
+//
+//	UnifiedDiff("a\nb\nc\n", "a\nB\nc\n", WithContext(1))
+//	// --- a
+//	// +++ b
+//	// @@ -1,3 +1,3 @@
+//	//  a
+//	// -b
+//	// +B
+//	//  c
+func UnifiedDiff(a, b string, opts ...DiffOption) string {
+	config := defaultDiffConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	edits := DiffLines(a, b)
+	hunks := diffHunks(edits, config.Context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	fmt.Fprintf(&result, "--- %s\n+++ %s\n", config.OldName, config.NewName)
+	for _, hunk := range hunks {
+		writeHunk(&result, hunk)
+	}
+	return result.String()
+}
+
+// diffHunks groups changes whose surrounding context overlaps, each with up
+// to context unchanged lines before and after
+func diffHunks(edits []Edit, context int) [][]Edit {
+	var hunks [][]Edit
+	start, end := -1, -1
+	for i, edit := range edits {
+		if edit.Op == EditEqual {
+			continue
+		}
+		if start >= 0 && i-end > 2*context {
+			hunks = append(hunks, edits[start:min(end+context, len(edits))])
+			start = -1
+		}
+		if start < 0 {
+			start = max(i-context, 0)
+		}
+		end = i + 1
+	}
+	if start >= 0 {
+		hunks = append(hunks, edits[start:min(end+context, len(edits))])
+	}
+	return hunks
+}
+
+// writeHunk writes one hunk with its @@ header
+func writeHunk(result *strings.Builder, hunk []Edit) {
+	oldCount, newCount := 0, 0
+	for _, edit := range hunk {
+		if edit.Op != EditInsert {
+			oldCount++
+		}
+		if edit.Op != EditDelete {
+			newCount++
+		}
+	}
+
+	fmt.Fprintf(result, "@@ -%s +%s @@\n",
+		hunkRange(hunk[0].OldLine, oldCount), hunkRange(hunk[0].NewLine, newCount))
+	for _, edit := range hunk {
+		result.WriteByte(edit.Op.prefix())
+		result.WriteString(edit.Text)
+		if !strings.HasSuffix(edit.Text, "\n") {
+			result.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the range of a hunk header from a zero-based start line.
+// As in GNU diff, a count of one is omitted and an empty range starts at the
+// line before it.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
@@ -0,0 +1,155 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected []sx.Edit
+	}{
+		{name: "equal", a: "a\nb\n", b: "a\nb\n", expected: []sx.Edit{
+			{Op: sx.EditEqual, OldLine: 0, NewLine: 0, Text: "a\n"},
+			{Op: sx.EditEqual, OldLine: 1, NewLine: 1, Text: "b\n"},
+		}},
+		{name: "replace", a: "a\nb\n", b: "a\nc\n", expected: []sx.Edit{
+			{Op: sx.EditEqual, OldLine: 0, NewLine: 0, Text: "a\n"},
+			{Op: sx.EditDelete, OldLine: 1, NewLine: 1, Text: "b\n"},
+			{Op: sx.EditInsert, OldLine: 2, NewLine: 1, Text: "c\n"},
+		}},
+		{name: "insert", a: "a\nc\n", b: "a\nb\nc\n", expected: []sx.Edit{
+			{Op: sx.EditEqual, OldLine: 0, NewLine: 0, Text: "a\n"},
+			{Op: sx.EditInsert, OldLine: 1, NewLine: 1, Text: "b\n"},
+			{Op: sx.EditEqual, OldLine: 1, NewLine: 2, Text: "c\n"},
+		}},
+		{name: "missing final newline", a: "a", b: "a\n", expected: []sx.Edit{
+			{Op: sx.EditDelete, OldLine: 0, NewLine: 0, Text: "a"},
+			{Op: sx.EditInsert, OldLine: 1, NewLine: 0, Text: "a\n"},
+		}},
+		{name: "both empty", a: "", b: "", expected: []sx.Edit{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.DiffLines(tt.a, tt.b)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("DiffLines(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffLines_ShortestScript(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	randomText := func() string {
+		lines := make([]string, r.IntN(12))
+		for i := range lines {
+			lines[i] = string(rune('a'+r.IntN(4))) + "\n"
+		}
+		return strings.Join(lines, "")
+	}
+
+	for range 500 {
+		a, b := randomText(), randomText()
+		edits := sx.DiffLines(a, b)
+
+		var oldText, newText strings.Builder
+		changes := 0
+		for _, edit := range edits {
+			if edit.Op != sx.EditInsert {
+				oldText.WriteString(edit.Text)
+			}
+			if edit.Op != sx.EditDelete {
+				newText.WriteString(edit.Text)
+			}
+			if edit.Op != sx.EditEqual {
+				changes++
+			}
+		}
+		if oldText.String() != a || newText.String() != b {
+			t.Fatalf("DiffLines(%q, %q) does not reproduce its inputs: %v", a, b, edits)
+		}
+
+		oldLines, newLines := strings.Count(a, "\n"), strings.Count(b, "\n")
+		if expected := oldLines + newLines - 2*lcsLength(a, b); changes != expected {
+			t.Fatalf("DiffLines(%q, %q) has %d changes, want %d", a, b, changes, expected)
+		}
+	}
+}
+
+// lcsLength returns the length of the longest common subsequence of lines
+func lcsLength(a, b string) int {
+	x, y := strings.SplitAfter(a, "\n"), strings.SplitAfter(b, "\n")
+	x, y = x[:len(x)-1], y[:len(y)-1]
+	table := make([][]int, len(x)+1)
+	for i := range table {
+		table[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	numbers := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+
+	tests := []struct {
+		name     string
+		a, b     string
+		opts     []sx.DiffOption
+		expected string
+	}{
+		{name: "equal", a: "a\n", b: "a\n", expected: ""},
+		{
+			name: "single change",
+			a:    "a\nb\nc\n", b: "a\nB\nc\n",
+			opts:     []sx.DiffOption{sx.WithContext(1)},
+			expected: "--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			name: "separate hunks",
+			a:    numbers, b: strings.Replace(strings.Replace(numbers, "2\n", "2x\n", 1), "11\n", "11x\n", 1) + "13",
+			expected: "--- a\n+++ b\n" +
+				"@@ -1,5 +1,5 @@\n 1\n-2\n+2x\n 3\n 4\n 5\n" +
+				"@@ -8,5 +8,6 @@\n 8\n 9\n 10\n-11\n+11x\n 12\n+13\n\\ No newline at end of file\n",
+		},
+		{
+			name: "merged hunks",
+			a:    numbers, b: strings.Replace(strings.Replace(numbers, "2\n", "2x\n", 1), "8\n", "8x\n", 1),
+			expected: "--- a\n+++ b\n@@ -1,11 +1,11 @@\n 1\n-2\n+2x\n 3\n 4\n 5\n 6\n 7\n-8\n+8x\n 9\n 10\n 11\n",
+		},
+		{
+			name: "zero context",
+			a:    "a\nb\nc\n", b: "a\nc\n",
+			opts:     []sx.DiffOption{sx.WithContext(0), sx.WithFileNames("old.txt", "new.txt")},
+			expected: "--- old.txt\n+++ new.txt\n@@ -2 +1,0 @@\n-b\n",
+		},
+		{name: "from empty", a: "", b: "x\n", expected: "--- a\n+++ b\n@@ -0,0 +1 @@\n+x\n"},
+		{name: "to empty", a: "x", b: "", expected: "--- a\n+++ b\n@@ -1 +0,0 @@\n-x\n\\ No newline at end of file\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.UnifiedDiff(tt.a, tt.b, tt.opts...)
+			if result != tt.expected {
+				t.Errorf("UnifiedDiff(%q, %q) =\n%s\nwant\n%s", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+//go:build !sx_ascii
+
+package sx
+
+import "unicode/utf8"
+
+// levenshtein returns the edit distance between a and b counted in runes:
+// the minimum number of insertions, deletions and substitutions needed to
+// turn one into the other
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+
+	// Single row of the dynamic programming table, sized by the shorter string
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			diag, row[j] = row[j], min(row[j]+1, row[j-1]+1, diag+cost)
+		}
+	}
+	return row[len(rb)]
+}
+
+// similarity returns the share of runes of the longer of a and b that need no
+// edit to turn one into the other: 1 for equal strings, 0 for entirely
+// different ones
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	return 1 - float64(levenshtein(a, b))/float64(max(utf8.RuneCountInString(a), utf8.RuneCountInString(b)))
+}
+
+// weightedLevenshtein is like levenshtein but charges substitute(x, y) for
+// replacing x with y; insertions and deletions cost 1
+func weightedLevenshtein(a, b []rune, substitute func(x, y rune) float64) float64 {
+	row := make([]float64, len(b)+1)
+	for j := range row {
+		row[j] = float64(j)
+	}
+	for i := 1; i <= len(a); i++ {
+		diag := row[0]
+		row[0] = float64(i)
+		for j := 1; j <= len(b); j++ {
+			cost := 0.0
+			if a[i-1] != b[j-1] {
+				cost = substitute(a[i-1], b[j-1])
+			}
+			diag, row[j] = row[j], min(row[j]+1, row[j-1]+1, diag+cost)
+		}
+	}
+	return row[len(b)]
+}
@@ -0,0 +1,160 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"unicode/utf8"
+)
+
+// Occurrence locates a substring within one of several texts
+type Occurrence struct {
+	// Doc is the index of the text
+	Doc int
+	// Offset is the byte offset of the substring within the text
+	Offset int
+}
+
+// Duplicate is a substring found in more than one text
+type Duplicate struct {
+	Text        string
+	Occurrences []Occurrence
+}
+
+// FindDuplicates reports substrings of at least minLen runes that occur in
+// two or more of texts, such as boilerplate copied between documents. Only
+// maximal repeats are reported: a duplicate is not returned if it always
+// appears as part of the same longer duplicate. Results are ordered longest
+// first, and occurrences by document and offset.
+//
+// The texts are indexed together in a suffix array with a distinct separator
+// after each one, so matches never span two texts.
+func FindDuplicates(texts []string, minLen int) []Duplicate {
+	duplicates, _ := FindDuplicatesContext(context.Background(), texts, minLen)
+	return duplicates
+}
+
+// FindDuplicatesContext is like FindDuplicates, but returns early with the
+// context's error if ctx is done before the search finishes
+func FindDuplicatesContext(ctx context.Context, texts []string, minLen int) ([]Duplicate, error) {
+	minLen = max(minLen, 1)
+
+	var total int
+	for _, text := range texts {
+		total += len(text) + 1
+	}
+	symbols := make([]int32, 0, total)
+	docs := make([]int32, 0, total)
+	starts := make([]int, 0, len(texts))
+	for d, text := range texts {
+		starts = append(starts, len(symbols))
+		for i := 0; i < len(text); i++ {
+			symbols = append(symbols, int32(text[i]))
+			docs = append(docs, int32(d))
+		}
+		symbols = append(symbols, int32(256+d))
+		docs = append(docs, int32(d))
+	}
+
+	sa, err := suffixArray(ctx, symbols, 256+len(texts))
+	if err != nil {
+		return nil, err
+	}
+	lcp, err := lcpArray(ctx, symbols, sa)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]*Duplicate)
+	report := func(length, lb, rb int) {
+		first := sa[lb]
+		text := texts[docs[first]]
+		offset := first - starts[docs[first]]
+
+		// Trim a partial rune at the end; skip matches starting mid-rune
+		if !utf8.RuneStart(text[offset]) {
+			return
+		}
+		for length > 0 && offset+length < len(text) && !utf8.RuneStart(text[offset+length]) {
+			length--
+		}
+		value := text[offset : offset+length]
+		if utf8.RuneCountInString(value) < minLen {
+			return
+		}
+
+		// Require two texts, and that the occurrences are not all preceded
+		// by the same byte (otherwise a longer duplicate covers this one)
+		var occurrences []Occurrence
+		multiDoc, leftMaximal := false, false
+		prev := int32(-1)
+		for _, p := range sa[lb : rb+1] {
+			occurrences = append(occurrences, Occurrence{Doc: int(docs[p]), Offset: p - starts[docs[p]]})
+			if docs[p] != docs[first] {
+				multiDoc = true
+			}
+			before := int32(-1)
+			if p > starts[docs[p]] {
+				before = symbols[p-1]
+			}
+			if before == -1 || prev != -1 && before != prev {
+				leftMaximal = true
+			}
+			prev = before
+		}
+		if !multiDoc || !leftMaximal {
+			return
+		}
+
+		if d, ok := found[value]; ok {
+			d.Occurrences = append(d.Occurrences, occurrences...)
+			return
+		}
+		found[value] = &Duplicate{Text: value, Occurrences: occurrences}
+	}
+
+	// Enumerate lcp-intervals bottom-up: each is a set of suffixes sharing a
+	// common prefix of the interval's length
+	type interval struct{ length, lb int }
+	stack := []interval{{0, 0}}
+	for i := 1; i <= len(sa); i++ {
+		if i%cancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		cur := 0
+		if i < len(sa) {
+			cur = lcp[i]
+		}
+		lb := i - 1
+		for cur < stack[len(stack)-1].length {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			report(top.length, top.lb, i-1)
+			lb = top.lb
+		}
+		if cur > stack[len(stack)-1].length {
+			stack = append(stack, interval{cur, lb})
+		}
+	}
+
+	duplicates := make([]Duplicate, 0, len(found))
+	for _, d := range found {
+		slices.SortFunc(d.Occurrences, func(a, b Occurrence) int {
+			return cmp.Or(cmp.Compare(a.Doc, b.Doc), cmp.Compare(a.Offset, b.Offset))
+		})
+		d.Occurrences = slices.Compact(d.Occurrences)
+		duplicates = append(duplicates, *d)
+	}
+	slices.SortFunc(duplicates, func(a, b Duplicate) int {
+		return cmp.Or(
+			cmp.Compare(len(b.Text), len(a.Text)),
+			cmp.Compare(a.Occurrences[0].Doc, b.Occurrences[0].Doc),
+			cmp.Compare(a.Occurrences[0].Offset, b.Occurrences[0].Offset),
+		)
+	})
+	return duplicates, nil
+}
@@ -0,0 +1,104 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	tests := []struct {
+		name     string
+		texts    []string
+		minLen   int
+		expected []sx.Duplicate
+	}{
+		{
+			name: "shared boilerplate",
+			texts: []string{
+				"Hello Ann. Copyright 2024 Acme Inc.",
+				"Dear Bob, Copyright 2024 Acme Inc. Bye",
+			},
+			minLen: 10,
+			expected: []sx.Duplicate{
+				{Text: " Copyright 2024 Acme Inc.", Occurrences: []sx.Occurrence{{Doc: 0, Offset: 10}, {Doc: 1, Offset: 9}}},
+			},
+		},
+		{
+			name:   "three documents",
+			texts:  []string{"xx shared block yy", "shared block", "zz shared block"},
+			minLen: 6,
+			expected: []sx.Duplicate{
+				{Text: " shared block", Occurrences: []sx.Occurrence{{Doc: 0, Offset: 2}, {Doc: 2, Offset: 2}}},
+				{Text: "shared block", Occurrences: []sx.Occurrence{{Doc: 0, Offset: 3}, {Doc: 1, Offset: 0}, {Doc: 2, Offset: 3}}},
+			},
+		},
+		{
+			name:     "repeats within one text are ignored",
+			texts:    []string{"abcdef abcdef", "xyz"},
+			minLen:   3,
+			expected: []sx.Duplicate{},
+		},
+		{
+			name:   "no match across boundary",
+			texts:  []string{"abc", "def", "abcdef"},
+			minLen: 3,
+			expected: []sx.Duplicate{
+				{Text: "abc", Occurrences: []sx.Occurrence{{Doc: 0, Offset: 0}, {Doc: 2, Offset: 0}}},
+				{Text: "def", Occurrences: []sx.Occurrence{{Doc: 1, Offset: 0}, {Doc: 2, Offset: 3}}},
+			},
+		},
+		{
+			name:   "min length in runes",
+			texts:  []string{"日本語です", "これは日本語"},
+			minLen: 3,
+			expected: []sx.Duplicate{
+				{Text: "日本語", Occurrences: []sx.Occurrence{{Doc: 0, Offset: 0}, {Doc: 1, Offset: 9}}},
+			},
+		},
+		{
+			name:     "too short",
+			texts:    []string{"abc", "abc"},
+			minLen:   4,
+			expected: []sx.Duplicate{},
+		},
+		{
+			name:     "empty",
+			texts:    nil,
+			minLen:   1,
+			expected: []sx.Duplicate{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.FindDuplicates(tt.texts, tt.minLen)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("FindDuplicates(%q, %d) = %+v, want %+v", tt.texts, tt.minLen, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindDuplicatesContext(t *testing.T) {
+	texts := []string{"Hello Ann. Copyright 2024 Acme Inc.", "Dear Bob, Copyright 2024 Acme Inc. Bye"}
+
+	result, err := sx.FindDuplicatesContext(context.Background(), texts, 10)
+	if err != nil {
+		t.Fatalf("FindDuplicatesContext() error = %v", err)
+	}
+	if expected := sx.FindDuplicates(texts, 10); !reflect.DeepEqual(result, expected) {
+		t.Errorf("FindDuplicatesContext() = %v, want %v", result, expected)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result, err := sx.FindDuplicatesContext(ctx, texts, 10); !errors.Is(err, context.Canceled) || result != nil {
+		t.Errorf("FindDuplicatesContext(canceled) = %v, %v, want nil, context.Canceled", result, err)
+	}
+}
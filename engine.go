@@ -0,0 +1,128 @@
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// streamable returns the string to convert in a single pass, scanning the
+// words of input straight into the output without collecting them first. ok
+// is false for slices and for configurations that need the whole split:
+// protected spans, affixes, lossless recording and number styles.
+func (c *Config) streamable(input any) (s string, ok bool) {
+	s, ok = input.(string)
+	if !ok || c.lossless != nil || len(c.ProtectedSpans) > 0 || len(c.ProtectedDelimiters) > 0 || len(c.ProtectedWords) > 0 ||
+		len(c.Prefixes) > 0 || len(c.Suffixes) > 0 || c.NumberStyle != NumberAttached {
+		return "", false
+	}
+	return c.sanitize(s), true
+}
+
+// newOutput returns a builder sized for converting s, allowing for a few
+// separators
+func newOutput(s, separator string) *strings.Builder {
+	var b strings.Builder
+	b.Grow(len(s) + len(s)/4*len(separator))
+	return &b
+}
+
+// streamDelimited converts s like delimitedCase in a single pass
+func (c *Config) streamDelimited(s, separator string) string {
+	b := newOutput(s, separator)
+	w := wordScanner{s: s, config: c}
+	for i := 0; ; i++ {
+		word, ok := w.next()
+		if !ok {
+			break
+		}
+		if i > 0 {
+			b.WriteString(separator)
+		}
+		writeLower(b, word)
+	}
+	return b.String()
+}
+
+// streamCapitalized converts s like capitalizeWords in a single pass
+func (c *Config) streamCapitalized(s, separator string) string {
+	b := newOutput(s, separator)
+	w := wordScanner{s: s, config: c}
+	i := 0
+	for word, ok := w.next(); ok; word, ok = w.next() {
+		if word == "" {
+			continue
+		}
+		if i > 0 {
+			b.WriteString(separator)
+		}
+		if acronym, ok := c.renderAcronym(word); ok {
+			b.WriteString(acronym)
+		} else {
+			writeCapitalized(b, word, c.Normalize)
+		}
+		i++
+	}
+	return b.String()
+}
+
+// streamCamel converts s like camelCase in a single pass
+func (c *Config) streamCamel(s string) string {
+	b := newOutput(s, "")
+	w := wordScanner{s: s, config: c}
+	first := true
+	for word, ok := w.next(); ok; word, ok = w.next() {
+		if word == "" {
+			continue
+		}
+		acronym, isAcronym := c.renderAcronym(word)
+		switch {
+		case first && isAcronym:
+			writeLower(b, word)
+		case first:
+			r, size := utf8.DecodeRuneInString(word)
+			b.WriteRune(toLower(r))
+			if c.Normalize {
+				writeLower(b, word[size:])
+			} else {
+				b.WriteString(word[size:])
+			}
+		case isAcronym:
+			b.WriteString(acronym)
+		default:
+			writeCapitalized(b, word, c.Normalize)
+		}
+		first = false
+	}
+	return b.String()
+}
+
+// writeLower writes word in lower case, like toLowerString but without
+// allocating for ASCII words
+func writeLower(b *strings.Builder, word string) {
+	for i := 0; i < len(word); i++ {
+		if word[i] >= utf8.RuneSelf {
+			b.WriteString(toLowerString(word))
+			return
+		}
+	}
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+}
+
+// writeCapitalized writes word like capitalizeWord, lowercasing the rest of
+// it first when normalize is set
+func writeCapitalized(b *strings.Builder, word string, normalize bool) {
+	r, size := utf8.DecodeRuneInString(word)
+	if normalize {
+		b.WriteRune(toUpper(toLower(r)))
+		writeLower(b, word[size:])
+		return
+	}
+	b.WriteRune(toUpper(r))
+	b.WriteString(word[size:])
+}
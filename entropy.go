@@ -0,0 +1,109 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Thresholds used by LooksLikeSecret
+const (
+	secretMinLength  = 16
+	secretHexEntropy = 3.0
+	secretEntropy    = 3.5
+	// Random tokens switch between lowercase, uppercase and digits far more
+	// often than words and numbers joined into identifiers do
+	secretMinTransitions = 0.2
+)
+
+// Entropy returns the Shannon entropy of s in bits per character, computed
+// over the distribution of its runes. Empty and single-symbol strings have
+// entropy 0; a string of n distinct runes has entropy log2(n).
+func Entropy(s string) float64 {
+	total := utf8.RuneCountInString(s)
+	if total == 0 {
+		return 0
+	}
+
+	var entropy float64
+	for _, count := range RuneFrequencies(s) {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// LooksLikeSecret reports whether s resembles a machine-generated credential
+// such as an API key, access token or password hash. It is a heuristic
+// combining length, character set and entropy: s must be at least 16
+// characters of token-safe ASCII with no whitespace, mix letters and digits
+// throughout rather than in separate words, and have high entropy for its
+// alphabet. Expect both false positives and false negatives; use it to flag
+// candidates, not to make final decisions.
+func LooksLikeSecret(s string) bool {
+	if len(s) < secretMinLength {
+		return false
+	}
+
+	var hasLetter, hasDigit bool
+	hexOnly := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+			hasLetter = true
+			if !strings.ContainsRune("abcdefABCDEF", rune(c)) {
+				hexOnly = false
+			}
+		case strings.IndexByte("+/=_-.~", c) >= 0:
+			hexOnly = false
+		default:
+			return false
+		}
+	}
+	if !hasLetter || !hasDigit || classTransitions(s) < secretMinTransitions {
+		return false
+	}
+
+	if hexOnly {
+		return Entropy(s) >= secretHexEntropy
+	}
+	return Entropy(s) >= secretEntropy
+}
+
+// classTransitions returns the fraction of adjacent character pairs within
+// each _, - or . separated segment of s whose classes (lowercase, uppercase,
+// digit, other) differ
+func classTransitions(s string) float64 {
+	class := func(c byte) int {
+		switch {
+		case c >= 'a' && c <= 'z':
+			return 0
+		case c >= 'A' && c <= 'Z':
+			return 1
+		case c >= '0' && c <= '9':
+			return 2
+		}
+		return 3
+	}
+
+	var pairs, transitions int
+	for segment := range strings.FieldsFuncSeq(s, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.'
+	}) {
+		for i := 1; i < len(segment); i++ {
+			pairs++
+			if class(segment[i-1]) != class(segment[i]) {
+				transitions++
+			}
+		}
+	}
+	if pairs == 0 {
+		return 0
+	}
+	return float64(transitions) / float64(pairs)
+}
@@ -0,0 +1,42 @@
+package sx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SyntaxError reports malformed input to one of the parsing functions
+type SyntaxError struct {
+	// Msg describes the problem
+	Msg string
+	// Offset is the byte offset in the input where the problem was detected
+	Offset int
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("sx: %s at offset %d", e.Msg, e.Offset)
+}
+
+// ErrInvalidAlphabet is returned by generators given an alphabet that is too
+// small, too large or contains duplicate characters
+var ErrInvalidAlphabet = errors.New("sx: invalid alphabet")
+
+// ErrPatchConflict is returned by ApplyPatch when the text does not match the
+// context or deleted lines of the patch
+var ErrPatchConflict = errors.New("sx: patch does not apply")
+
+// ErrInvalidConfig is returned by NewConfig and Config.Validate for a
+// configuration that cannot be used
+var ErrInvalidConfig = errors.New("sx: invalid config")
+
+// ErrInvalidSlug is returned for a value that is not a valid Slug and cannot
+// be normalized to one
+var ErrInvalidSlug = errors.New("sx: invalid slug")
+
+// ErrInvalidIdentifier is returned for a value that is not a valid
+// Identifier and cannot be normalized to one
+var ErrInvalidIdentifier = errors.New("sx: invalid identifier")
+
+// ErrDuplicateKey is returned by ConvertKeys and ConvertJSONKeys when two
+// keys of an object convert to the same name
+var ErrDuplicateKey = errors.New("sx: duplicate key")
@@ -0,0 +1,100 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	urlPattern     = regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s<>"'` + "`" + `]+`)
+	emailPattern   = regexp.MustCompile(`[A-Za-z0-9][A-Za-z0-9._%+-]*@(?:[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?\.)+[A-Za-z]{2,}`)
+	mentionPattern = regexp.MustCompile(`@[A-Za-z0-9_]+`)
+	hashtagPattern = regexp.MustCompile(`#[\p{L}\p{N}_]+`)
+)
+
+// ExtractURLs returns the http, https and ftp URLs found in s. Trailing
+// punctuation that usually ends a sentence, and closing parentheses without
+// a matching opening one, are not considered part of the URL.
+func ExtractURLs(s string) []Match {
+	var matches []Match
+	for _, loc := range urlPattern.FindAllStringIndex(s, -1) {
+		end := trimURLEnd(s[loc[0]:loc[1]]) + loc[0]
+		matches = append(matches, Match{Value: s[loc[0]:end], Start: loc[0], End: end})
+	}
+	return matches
+}
+
+// trimURLEnd returns the length of url without trailing punctuation
+func trimURLEnd(url string) int {
+	end := len(url)
+	for end > 0 {
+		switch url[end-1] {
+		case '.', ',', ';', ':', '!', '?', '\'', '"':
+			end--
+			continue
+		case ')':
+			if strings.Count(url[:end], "(") < strings.Count(url[:end], ")") {
+				end--
+				continue
+			}
+		}
+		break
+	}
+	return end
+}
+
+// ExtractEmails returns the email addresses found in s
+func ExtractEmails(s string) []Match {
+	var matches []Match
+	for _, loc := range emailPattern.FindAllStringIndex(s, -1) {
+		if !boundaryBefore(s, loc[0]) || strings.Contains(s[loc[0]:loc[1]], "..") {
+			continue
+		}
+		matches = append(matches, Match{Value: s[loc[0]:loc[1]], Start: loc[0], End: loc[1]})
+	}
+	return matches
+}
+
+// ExtractMentions returns @mentions found in s, including the leading '@'.
+// The '@' of an email address is not treated as a mention.
+func ExtractMentions(s string) []Match {
+	return extractSigil(s, mentionPattern, func(string) bool { return true })
+}
+
+// ExtractHashtags returns #hashtags found in s, including the leading '#'.
+// Tags made only of digits ("#1") are ignored.
+func ExtractHashtags(s string) []Match {
+	return extractSigil(s, hashtagPattern, func(tag string) bool {
+		return strings.IndexFunc(tag, unicode.IsLetter) >= 0
+	})
+}
+
+// extractSigil finds pattern matches that start at a word boundary and end
+// before another word character, keeping those accepted by valid
+func extractSigil(s string, pattern *regexp.Regexp, valid func(string) bool) []Match {
+	var matches []Match
+	for _, loc := range pattern.FindAllStringIndex(s, -1) {
+		if !boundaryBefore(s, loc[0]) || !valid(s[loc[0]:loc[1]]) {
+			continue
+		}
+		// Reject mentions that are really the local part of an email address
+		if loc[1] < len(s) && (s[loc[1]] == '@' || s[loc[1]] == '.' && loc[1]+1 < len(s) && isASCIIAlnum(s[loc[1]+1])) {
+			continue
+		}
+		matches = append(matches, Match{Value: s[loc[0]:loc[1]], Start: loc[0], End: loc[1]})
+	}
+	return matches
+}
+
+// boundaryBefore reports whether the rune before byte offset i is not part of a word
+func boundaryBefore(s string, i int) bool {
+	if i == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '@' && r != '#' && r != '.'
+}
@@ -0,0 +1,129 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func matchValues(matches []sx.Match) []string {
+	var result []string
+	for _, m := range matches {
+		result = append(result, m.Value)
+	}
+	return result
+}
+
+func TestExtractURLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "sentence punctuation",
+			input:    "See https://example.com/docs. Or http://go.dev, maybe!",
+			expected: []string{"https://example.com/docs", "http://go.dev"},
+		},
+		{
+			name:     "parentheses",
+			input:    "(see https://en.wikipedia.org/wiki/Go_(language))",
+			expected: []string{"https://en.wikipedia.org/wiki/Go_(language)"},
+		},
+		{
+			name:     "query and fragment",
+			input:    "open <https://x.io/a?b=1&c=2#frag> now",
+			expected: []string{"https://x.io/a?b=1&c=2#frag"},
+		},
+		{
+			name:     "no scheme",
+			input:    "example.com is not matched",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchValues(sx.ExtractURLs(tt.input))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ExtractURLs(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExtractEmails(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "multiple",
+			input:    "Contact jane.doe+news@example.co.uk or ops@my-host.io.",
+			expected: []string{"jane.doe+news@example.co.uk", "ops@my-host.io"},
+		},
+		{
+			name:     "invalid",
+			input:    "user@localhost, @example.com, a..b@x.com",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchValues(sx.ExtractEmails(tt.input))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ExtractEmails(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExtractMentions(t *testing.T) {
+	input := "@alice thanks, cc @bob_2 (not bob@example.com)"
+	expected := []sx.Match{
+		{Value: "@alice", Start: 0, End: 6},
+		{Value: "@bob_2", Start: 18, End: 24},
+	}
+
+	if result := sx.ExtractMentions(input); !reflect.DeepEqual(result, expected) {
+		t.Errorf("ExtractMentions(%q) = %v, want %v", input, result, expected)
+	}
+}
+
+func TestExtractHashtags(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "basic",
+			input:    "Loving #golang and #go_lang2!",
+			expected: []string{"#golang", "#go_lang2"},
+		},
+		{
+			name:     "unicode",
+			input:    "#café #日本",
+			expected: []string{"#café", "#日本"},
+		},
+		{
+			name:     "numbers and anchors ignored",
+			input:    "issue #123 and page.html#section",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchValues(sx.ExtractHashtags(tt.input))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ExtractHashtags(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// unchanged reports whether converting s would give back s itself: joining
+// its words with separator reproduces s, and keep reports that every word is
+// rendered as is. It lets the converters return input that is already in the
+// target case without splitting and joining it, and does not allocate.
+func (c *Config) unchanged(input any, separator string, keep func(word string, i int) bool) (string, bool) {
+	s, ok := input.(string)
+	if !ok || s == "" || c.lossless != nil || len(c.ProtectedSpans) > 0 || len(c.ProtectedDelimiters) > 0 ||
+		len(c.ProtectedWords) > 0 || len(c.Prefixes) > 0 || len(c.Suffixes) > 0 || c.NumberStyle != NumberAttached ||
+		c.InvalidUTF8 != UTF8Preserve && !utf8.ValidString(s) {
+		return "", false
+	}
+
+	w := wordScanner{s: s, config: c}
+	pos, i := 0, 0
+	for word, ok := w.next(); ok; word, ok = w.next() {
+		if word == "" {
+			// Empty words are dropped or joined to neighbours, either way changing s
+			return "", false
+		}
+		if i > 0 {
+			if !strings.HasPrefix(s[pos:], separator) {
+				return "", false
+			}
+			pos += len(separator)
+		}
+		if !strings.HasPrefix(s[pos:], word) || !keep(word, i) {
+			return "", false
+		}
+		pos += len(word)
+		i++
+	}
+	return s, pos == len(s)
+}
+
+// keepsLower reports whether lowercasing word leaves it unchanged
+func keepsLower(word string) bool {
+	for _, r := range word {
+		if toLower(r) != r {
+			return false
+		}
+	}
+	return true
+}
+
+// keepsAcronym reports whether word is an acronym rendered as is; ok is
+// false when word is not an acronym
+func (c *Config) keepsAcronym(word string) (keep, ok bool) {
+	acronym, ok := c.renderAcronym(word)
+	return acronym == word, ok
+}
+
+// keepsCapitalized reports whether capitalizeWords renders word unchanged
+func (c *Config) keepsCapitalized(word string, _ int) bool {
+	if keep, ok := c.keepsAcronym(word); ok {
+		return keep
+	}
+	r, size := utf8.DecodeRuneInString(word)
+	if c.Normalize {
+		return toUpper(toLower(r)) == r && keepsLower(word[size:])
+	}
+	return toUpper(r) == r
+}
+
+// keepsCamel reports whether CamelCase renders the i-th word unchanged
+func (c *Config) keepsCamel(word string, i int) bool {
+	if i > 0 {
+		return c.keepsCapitalized(word, i)
+	}
+	if _, ok := c.renderAcronym(word); ok || c.Normalize {
+		return keepsLower(word)
+	}
+	r, _ := utf8.DecodeRuneInString(word)
+	return toLower(r) == r
+}
+
+// keepsDelimited reports whether DelimitedCase renders word unchanged
+func keepsDelimited(word string, _ int) bool {
+	return keepsLower(word)
+}
@@ -0,0 +1,166 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"slices"
+)
+
+// FieldMatchOption configures MatchFields
+type FieldMatchOption func(*FieldMatchConfig)
+
+// FieldMatchConfig holds the configuration for MatchFields
+type FieldMatchConfig struct {
+	// MinSimilarity is the lowest similarity, between 0 and 1, at which two
+	// names that are not the same identifier are paired (default 0.7)
+	MinSimilarity float64
+}
+
+// defaultFieldMatchConfig returns the default configuration
+func defaultFieldMatchConfig() *FieldMatchConfig {
+	return &FieldMatchConfig{MinSimilarity: 0.7}
+}
+
+// WithMinSimilarity sets the lowest similarity at which names are paired
+// when they are not the same identifier
+func WithMinSimilarity(similarity float64) FieldMatchOption {
+	return func(c *FieldMatchConfig) {
+		c.MinSimilarity = similarity
+	}
+}
+
+// FieldAmbiguity reports names MatchFields left unpaired because they matched
+// each other equally well: every source matches some of the targets with
+// the same similarity
+type FieldAmbiguity struct {
+	Sources []string
+	Targets []string
+}
+
+// fieldCandidate is a possible pairing of the source and target with the
+// given indices
+type fieldCandidate struct {
+	source, target int
+	similarity     float64
+}
+
+// MatchFields pairs source names with target names, such as CSV columns with
+// struct fields. Names that are the same identifier in any case style, like
+// "user_id" and "UserID", are paired first; the remaining ones by
+// similarity, the share of runes of the identifiers that need no edit to
+// turn one into the other. The result maps each paired source to its
+// target. Names with several equally good candidates are left unpaired and
+// reported as ambiguities.
+//
+// Example:
+//
+//	MatchFields([]string{"First Name", "e-mail", "adress"}, []string{"FirstName", "Email", "Address"})
+//	// map[First Name:FirstName adress:Address e-mail:Email], no ambiguities
+func MatchFields(sources, targets []string, opts ...FieldMatchOption) (map[string]string, []FieldAmbiguity) {
+	config := defaultFieldMatchConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	sourceKeys := fieldKeys(sources)
+	targetKeys := fieldKeys(targets)
+	var candidates []fieldCandidate
+	for i, source := range sourceKeys {
+		for j, target := range targetKeys {
+			if source == "" || target == "" {
+				continue
+			}
+			if s := similarity(source, target); s == 1 || s >= config.MinSimilarity {
+				candidates = append(candidates, fieldCandidate{i, j, s})
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b fieldCandidate) int {
+		return cmp.Compare(b.similarity, a.similarity)
+	})
+
+	matches := make(map[string]string)
+	var ambiguities []FieldAmbiguity
+	sourceDone := make([]bool, len(sources))
+	targetDone := make([]bool, len(targets))
+	for len(candidates) > 0 {
+		// Resolve the candidates of the best remaining similarity together
+		n := 1
+		for n < len(candidates) && candidates[n].similarity == candidates[0].similarity {
+			n++
+		}
+		var group []fieldCandidate
+		for _, c := range candidates[:n] {
+			if !sourceDone[c.source] && !targetDone[c.target] {
+				group = append(group, c)
+			}
+		}
+		candidates = candidates[n:]
+
+		for _, component := range fieldComponents(group) {
+			for _, c := range component {
+				sourceDone[c.source] = true
+				targetDone[c.target] = true
+			}
+			if len(component) == 1 {
+				matches[sources[component[0].source]] = targets[component[0].target]
+				continue
+			}
+			ambiguities = append(ambiguities, newFieldAmbiguity(component, sources, targets))
+		}
+	}
+	return matches, ambiguities
+}
+
+// fieldKeys returns the identifier form of each name, which is equal for
+// names that differ only in case style
+func fieldKeys(names []string) []string {
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = emptyConfig.delimitedCase(name, "")
+	}
+	return keys
+}
+
+// fieldComponents splits candidates into groups connected by a shared source
+// or target, in order of first appearance
+func fieldComponents(candidates []fieldCandidate) [][]fieldCandidate {
+	var components [][]fieldCandidate
+	assigned := make([]bool, len(candidates))
+	for i := range candidates {
+		if assigned[i] {
+			continue
+		}
+		assigned[i] = true
+		component := []fieldCandidate{candidates[i]}
+		for k := 0; k < len(component); k++ {
+			for j, c := range candidates {
+				if !assigned[j] && (c.source == component[k].source || c.target == component[k].target) {
+					assigned[j] = true
+					component = append(component, c)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// newFieldAmbiguity returns the names involved in the candidates, in input
+// order
+func newFieldAmbiguity(candidates []fieldCandidate, sources, targets []string) FieldAmbiguity {
+	var sourceIndices, targetIndices []int
+	for _, c := range candidates {
+		sourceIndices = append(sourceIndices, c.source)
+		targetIndices = append(targetIndices, c.target)
+	}
+	var ambiguity FieldAmbiguity
+	for _, i := range slices.Compact(slices.Sorted(slices.Values(sourceIndices))) {
+		ambiguity.Sources = append(ambiguity.Sources, sources[i])
+	}
+	for _, i := range slices.Compact(slices.Sorted(slices.Values(targetIndices))) {
+		ambiguity.Targets = append(ambiguity.Targets, targets[i])
+	}
+	return ambiguity
+}
@@ -0,0 +1,77 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestMatchFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		sources     []string
+		targets     []string
+		options     []sx.FieldMatchOption
+		expected    map[string]string
+		ambiguities []sx.FieldAmbiguity
+	}{
+		{
+			name:     "same identifiers",
+			sources:  []string{"user_id", "First Name", "e-mail"},
+			targets:  []string{"Email", "UserID", "FirstName"},
+			expected: map[string]string{"user_id": "UserID", "First Name": "FirstName", "e-mail": "Email"},
+		},
+		{
+			name:     "fuzzy fallback",
+			sources:  []string{"adress", "custmer_name", "zip"},
+			targets:  []string{"Address", "CustomerName", "Country"},
+			expected: map[string]string{"adress": "Address", "custmer_name": "CustomerName"},
+		},
+		{
+			name:     "exact match wins over fuzzy",
+			sources:  []string{"name", "names"},
+			targets:  []string{"Names"},
+			expected: map[string]string{"names": "Names"},
+		},
+		{
+			name:     "similarity threshold",
+			sources:  []string{"adress"},
+			targets:  []string{"Address"},
+			options:  []sx.FieldMatchOption{sx.WithMinSimilarity(0.9)},
+			expected: map[string]string{},
+		},
+		{
+			name:        "ambiguous targets",
+			sources:     []string{"user_id", "name"},
+			targets:     []string{"UserID", "userId", "Name"},
+			expected:    map[string]string{"name": "Name"},
+			ambiguities: []sx.FieldAmbiguity{{Sources: []string{"user_id"}, Targets: []string{"UserID", "userId"}}},
+		},
+		{
+			name:        "ambiguous sources",
+			sources:     []string{"colour", "collor"},
+			targets:     []string{"color"},
+			expected:    map[string]string{},
+			ambiguities: []sx.FieldAmbiguity{{Sources: []string{"colour", "collor"}, Targets: []string{"color"}}},
+		},
+		{
+			name:     "empty",
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ambiguities := sx.MatchFields(tt.sources, tt.targets, tt.options...)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("MatchFields() = %v, want %v", result, tt.expected)
+			}
+			if !reflect.DeepEqual(ambiguities, tt.ambiguities) {
+				t.Errorf("MatchFields() ambiguities = %v, want %v", ambiguities, tt.ambiguities)
+			}
+		})
+	}
+}
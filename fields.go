@@ -0,0 +1,102 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FieldKind identifies the type of a Field
+type FieldKind int
+
+const (
+	// FieldWord is a bare word such as error
+	FieldWord FieldKind = iota
+	// FieldPhrase is a quoted phrase such as "exact phrase"
+	FieldPhrase
+	// FieldKeyValue is a qualified term such as level:error or name:"a b"
+	FieldKeyValue
+)
+
+// String returns the name of the kind
+func (k FieldKind) String() string {
+	switch k {
+	case FieldWord:
+		return "word"
+	case FieldPhrase:
+		return "phrase"
+	case FieldKeyValue:
+		return "key-value"
+	default:
+		return "unknown"
+	}
+}
+
+// Field is a token produced by ParseFields. Start and End are byte offsets of
+// the whole token in the input.
+type Field struct {
+	Kind  FieldKind
+	Key   string
+	Value string
+	Start int
+	End   int
+}
+
+// ParseFields splits search-box style input such as
+// `level:error service:api "exact phrase"` into words, quoted phrases and
+// key:value terms. Values of key:value terms may be quoted. Parsing is
+// forgiving: an unterminated quote extends to the end of the input.
+func ParseFields(s string) []Field {
+	var fields []Field
+
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			i += size
+			continue
+		}
+
+		start := i
+		if r == '"' {
+			value, end := scanPhrase(s, i)
+			fields = append(fields, Field{Kind: FieldPhrase, Value: value, Start: start, End: end})
+			i = end
+			continue
+		}
+
+		end := i
+		for end < len(s) {
+			r, size := utf8.DecodeRuneInString(s[end:])
+			if unicode.IsSpace(r) || r == '"' {
+				break
+			}
+			end += size
+		}
+		word := s[i:end]
+
+		if key, value, ok := strings.Cut(word, ":"); ok && key != "" {
+			if value == "" && end < len(s) && s[end] == '"' {
+				value, end = scanPhrase(s, end)
+			}
+			fields = append(fields, Field{Kind: FieldKeyValue, Key: key, Value: value, Start: start, End: end})
+		} else {
+			fields = append(fields, Field{Kind: FieldWord, Value: word, Start: start, End: end})
+		}
+		i = end
+	}
+
+	return fields
+}
+
+// scanPhrase reads a double-quoted phrase at s[i] and returns its content and
+// the offset after the closing quote (or len(s) when unterminated)
+func scanPhrase(s string, i int) (string, int) {
+	value, end, err := scanQuoted(s, i)
+	if err != nil {
+		return s[i+1:], len(s)
+	}
+	return value, end
+}
@@ -0,0 +1,72 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []sx.Field
+	}{
+		{
+			name:  "mixed",
+			input: `level:error service:api "exact phrase" timeout`,
+			expected: []sx.Field{
+				{Kind: sx.FieldKeyValue, Key: "level", Value: "error", Start: 0, End: 11},
+				{Kind: sx.FieldKeyValue, Key: "service", Value: "api", Start: 12, End: 23},
+				{Kind: sx.FieldPhrase, Value: "exact phrase", Start: 24, End: 38},
+				{Kind: sx.FieldWord, Value: "timeout", Start: 39, End: 46},
+			},
+		},
+		{
+			name:  "quoted value",
+			input: `name:"John Smith"`,
+			expected: []sx.Field{
+				{Kind: sx.FieldKeyValue, Key: "name", Value: "John Smith", Start: 0, End: 17},
+			},
+		},
+		{
+			name:  "value containing colon",
+			input: `url:http://x`,
+			expected: []sx.Field{
+				{Kind: sx.FieldKeyValue, Key: "url", Value: "http://x", Start: 0, End: 12},
+			},
+		},
+		{
+			name:  "leading colon is a word",
+			input: `:odd`,
+			expected: []sx.Field{
+				{Kind: sx.FieldWord, Value: ":odd", Start: 0, End: 4},
+			},
+		},
+		{
+			name:  "unterminated quote",
+			input: `a "open ended`,
+			expected: []sx.Field{
+				{Kind: sx.FieldWord, Value: "a", Start: 0, End: 1},
+				{Kind: sx.FieldPhrase, Value: "open ended", Start: 2, End: 13},
+			},
+		},
+		{
+			name:     "blank",
+			input:    "   ",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.ParseFields(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseFields(%q) = %+v, want %+v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Filter returns s with only the runes for which keep returns true. s itself
+// is returned when every rune is kept.
+//
+// Example:
+//
+//	Filter("a1-b2", unicode.IsLetter) // ab
+func Filter(s string, keep func(rune) bool) string {
+	return strings.Map(func(r rune) rune {
+		if keep(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Digits returns the decimal digits of s, in any script
+//
+// Example:
+//
+//	Digits("+1 (555) 010-9999") // 15550109999
+func Digits(s string) string {
+	return Filter(s, unicode.IsDigit)
+}
+
+// Letters returns the letters of s, in any script
+func Letters(s string) string {
+	return Filter(s, unicode.IsLetter)
+}
+
+// Alphanumeric returns the letters and decimal digits of s, in any script
+//
+// Example:
+//
+//	Alphanumeric("Order #42-B!") // Order42B
+func Alphanumeric(s string) string {
+	return Filter(s, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })
+}
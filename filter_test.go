@@ -0,0 +1,36 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/gomantics/sx"
+)
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		function func(string) string
+		input    string
+		expected string
+	}{
+		{name: "Filter", function: func(s string) string { return sx.Filter(s, unicode.IsUpper) }, input: "Hello World", expected: "HW"},
+		{name: "Filter none kept", function: func(s string) string { return sx.Filter(s, unicode.IsDigit) }, input: "abc", expected: ""},
+		{name: "Digits", function: sx.Digits, input: "+1 (555) 010-9999", expected: "15550109999"},
+		{name: "Digits other scripts", function: sx.Digits, input: "\u0663 and 4", expected: "\u06634"},
+		{name: "Letters", function: sx.Letters, input: "caf\u00e9-42 \u00fcber", expected: "caf\u00e9\u00fcber"},
+		{name: "Alphanumeric", function: sx.Alphanumeric, input: "Order #42-B!", expected: "Order42B"},
+		{name: "Alphanumeric unchanged", function: sx.Alphanumeric, input: "abc123", expected: "abc123"},
+		{name: "Alphanumeric empty", function: sx.Alphanumeric, input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.function(tt.input); result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// fitNameHashLen is the number of hex digits in the suffix appended by FitName
+const fitNameHashLen = 5
+
+// FitName returns s unchanged if it is at most max bytes long. Otherwise it
+// truncates s and appends a short hash of the full input, Kubernetes style:
+// "my-very-long-deployment-name" with max 20 becomes "my-very-long-d-"
+// followed by five hex digits.
+// The result is deterministic, and distinct long inputs sharing a prefix get
+// distinct names. The hash is joined with '_' when s uses underscores and
+// no hyphens, and with '-' otherwise. Trailing separators are trimmed from
+// the truncated part.
+func FitName(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+
+	sum := sha256.Sum256([]byte(s))
+	hash := hex.EncodeToString(sum[:])[:fitNameHashLen]
+
+	sep := "-"
+	if strings.Contains(s, "_") && !strings.Contains(s, "-") {
+		sep = "_"
+	}
+
+	keep := max - len(sep) - len(hash)
+	if keep <= 0 {
+		return hash[:clamp(max, 0, len(hash))]
+	}
+
+	prefix := strings.TrimRight(truncateBytes(s, keep), "-_. ")
+	if prefix == "" {
+		return hash
+	}
+	return prefix + sep + hash
+}
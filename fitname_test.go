@@ -0,0 +1,63 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestFitName(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		max    int
+		prefix string
+		sep    string
+	}{
+		{name: "fits", input: "api", max: 10, prefix: "api", sep: ""},
+		{name: "kebab", input: "my-very-long-deployment-name", max: 20, prefix: "my-very-long-d", sep: "-"},
+		{name: "trailing separator trimmed", input: "my-very-long-deployment-name", max: 19, prefix: "my-very-long", sep: "-"},
+		{name: "snake", input: "customer_transaction_history_idx", max: 20, prefix: "customer_trans", sep: "_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.FitName(tt.input, tt.max)
+			if len(result) > tt.max {
+				t.Errorf("FitName(%q, %d) = %q exceeds max", tt.input, tt.max, result)
+			}
+			if tt.sep == "" {
+				if result != tt.input {
+					t.Errorf("FitName(%q, %d) = %q, want unchanged", tt.input, tt.max, result)
+				}
+				return
+			}
+			if !strings.HasPrefix(result, tt.prefix+tt.sep) || len(result) != len(tt.prefix)+len(tt.sep)+5 {
+				t.Errorf("FitName(%q, %d) = %q, want %q%s<hash>", tt.input, tt.max, result, tt.prefix, tt.sep)
+			}
+			if again := sx.FitName(tt.input, tt.max); again != result {
+				t.Errorf("FitName is not deterministic: %q != %q", again, result)
+			}
+		})
+	}
+}
+
+func TestFitName_Unique(t *testing.T) {
+	a := sx.FitName("service-account-token-primary", 16)
+	b := sx.FitName("service-account-token-secondary", 16)
+	if a == b {
+		t.Errorf("FitName produced the same name %q for different inputs", a)
+	}
+}
+
+func TestFitName_TinyBudget(t *testing.T) {
+	if got := sx.FitName("abcdefgh", 3); len(got) != 3 {
+		t.Errorf("FitName(%q, 3) = %q, want 3 hash characters", "abcdefgh", got)
+	}
+	if got := sx.FitName("abcdefgh", 0); got != "" {
+		t.Errorf("FitName(%q, 0) = %q, want empty", "abcdefgh", got)
+	}
+}
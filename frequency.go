@@ -0,0 +1,47 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"slices"
+)
+
+// RuneCount is a rune together with the number of times it occurs
+type RuneCount struct {
+	Rune  rune
+	Count int
+}
+
+// RuneFrequencies counts how many times each rune occurs in s. Invalid UTF-8
+// bytes are counted as utf8.RuneError.
+func RuneFrequencies(s string) map[rune]int {
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+	return counts
+}
+
+// TopRunes returns the n most frequent runes in s, most frequent first. Ties
+// are broken by rune value so the result is deterministic. A negative n
+// returns every rune.
+func TopRunes(s string, n int) []RuneCount {
+	counts := RuneFrequencies(s)
+	top := make([]RuneCount, 0, len(counts))
+	for r, count := range counts {
+		top = append(top, RuneCount{Rune: r, Count: count})
+	}
+
+	slices.SortFunc(top, func(a, b RuneCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Rune, b.Rune)
+	})
+
+	if n >= 0 && n < len(top) {
+		top = top[:n]
+	}
+	return top
+}
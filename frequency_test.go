@@ -0,0 +1,73 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gomantics/sx"
+)
+
+func TestRuneFrequencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[rune]int
+	}{
+		{name: "empty", input: "", expected: map[rune]int{}},
+		{name: "ascii", input: "hello", expected: map[rune]int{'h': 1, 'e': 1, 'l': 2, 'o': 1}},
+		{name: "unicode", input: "日日本", expected: map[rune]int{'日': 2, '本': 1}},
+		{name: "invalid utf-8", input: "a\xff\xfe", expected: map[rune]int{'a': 1, utf8.RuneError: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.RuneFrequencies(tt.input)
+			if !maps.Equal(result, tt.expected) {
+				t.Errorf("RuneFrequencies(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTopRunes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		n        int
+		expected []sx.RuneCount
+	}{
+		{
+			name:     "top two",
+			input:    "mississippi",
+			n:        2,
+			expected: []sx.RuneCount{{Rune: 'i', Count: 4}, {Rune: 's', Count: 4}},
+		},
+		{
+			name:  "all",
+			input: "mississippi",
+			n:     -1,
+			expected: []sx.RuneCount{
+				{Rune: 'i', Count: 4},
+				{Rune: 's', Count: 4},
+				{Rune: 'p', Count: 2},
+				{Rune: 'm', Count: 1},
+			},
+		},
+		{name: "n larger than distinct", input: "aab", n: 10, expected: []sx.RuneCount{{Rune: 'a', Count: 2}, {Rune: 'b', Count: 1}}},
+		{name: "zero", input: "abc", n: 0, expected: []sx.RuneCount{}},
+		{name: "empty", input: "", n: 3, expected: []sx.RuneCount{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.TopRunes(tt.input, tt.n)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("TopRunes(%q, %d) = %v, want %v", tt.input, tt.n, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"slices"
+)
+
+// bkNode is a node of a BK-tree. Children are keyed by their edit distance
+// to the node's word.
+type bkNode struct {
+	word     string
+	children map[int]*bkNode
+}
+
+// FuzzySet is a set of words that answers approximate lookups: all words
+// within a given edit distance of a query. It is backed by a BK-tree, so a
+// lookup visits only a fraction of the words instead of comparing the query
+// against each of them. The zero value is an empty set ready to use.
+type FuzzySet struct {
+	root *bkNode
+	size int
+}
+
+// NewFuzzySet returns a FuzzySet containing words
+func NewFuzzySet(words ...string) *FuzzySet {
+	s := &FuzzySet{}
+	for _, word := range words {
+		s.Add(word)
+	}
+	return s
+}
+
+// Add inserts word into the set. Adding a word already present has no effect.
+func (s *FuzzySet) Add(word string) {
+	if s.root == nil {
+		s.root = &bkNode{word: word}
+		s.size++
+		return
+	}
+
+	node := s.root
+	for {
+		d := levenshtein(word, node.word)
+		if d == 0 {
+			return
+		}
+		child, ok := node.children[d]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[int]*bkNode)
+			}
+			node.children[d] = &bkNode{word: word}
+			s.size++
+			return
+		}
+		node = child
+	}
+}
+
+// Len returns the number of words in the set
+func (s *FuzzySet) Len() int {
+	return s.size
+}
+
+// Within returns every word whose Levenshtein distance to query is at most k,
+// closest first and alphabetically among equally close words
+//
+// Example:
+//
+//	NewFuzzySet("book", "books", "cake", "boo", "cape").Within("bok", 1)
+//	// []string{"boo", "book"}
+func (s *FuzzySet) Within(query string, k int) []string {
+	type match struct {
+		word     string
+		distance int
+	}
+
+	var matches []match
+	if s.root != nil && k >= 0 {
+		stack := []*bkNode{s.root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			d := levenshtein(query, node.word)
+			if d <= k {
+				matches = append(matches, match{word: node.word, distance: d})
+			}
+			// By the triangle inequality only children in [d-k, d+k] can match
+			for cd, child := range node.children {
+				if cd >= d-k && cd <= d+k {
+					stack = append(stack, child)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b match) int {
+		if c := cmp.Compare(a.distance, b.distance); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.word, b.word)
+	})
+
+	words := make([]string, len(matches))
+	for i, m := range matches {
+		words[i] = m.word
+	}
+	return words
+}
@@ -0,0 +1,55 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestFuzzySet_Within(t *testing.T) {
+	set := sx.NewFuzzySet("book", "books", "cake", "boo", "cape", "cart", "boon", "cook", "caf\u00e9")
+
+	tests := []struct {
+		name     string
+		query    string
+		k        int
+		expected []string
+	}{
+		{name: "exact", query: "cake", k: 0, expected: []string{"cake"}},
+		{name: "one edit", query: "bok", k: 1, expected: []string{"boo", "book"}},
+		{name: "two edits", query: "bok", k: 2, expected: []string{"boo", "book", "books", "boon", "cook"}},
+		{name: "ordered by distance", query: "book", k: 1, expected: []string{"book", "boo", "books", "boon", "cook"}},
+		{name: "runes", query: "cafe", k: 1, expected: []string{"caf\u00e9", "cake", "cape"}},
+		{name: "no match", query: "xyz", k: 1, expected: []string{}},
+		{name: "negative k", query: "book", k: -1, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := set.Within(tt.query, tt.k)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("Within(%q, %d) = %q, want %q", tt.query, tt.k, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFuzzySet_Add(t *testing.T) {
+	var set sx.FuzzySet
+	if result := set.Within("a", 3); len(result) != 0 {
+		t.Errorf("empty Within() = %q, want none", result)
+	}
+
+	set.Add("alpha")
+	set.Add("alpha")
+	set.Add("alpine")
+	if set.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", set.Len())
+	}
+	if result := set.Within("alpna", 1); !slices.Equal(result, []string{"alpha"}) {
+		t.Errorf("Within(%q, 1) = %q, want [alpha]", "alpna", result)
+	}
+}
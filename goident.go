@@ -0,0 +1,221 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+)
+
+// snippetPackage is prepended to Go snippets without a package clause
+const snippetPackage = "package snippet\n\n"
+
+// RenameGoIdentifiers applies rename to every identifier declared in the Go
+// source src (types, functions, methods, fields, variables, constants,
+// parameters and labels) and to all references to them, and returns the
+// result formatted with go/format. src may be a complete file or a list of
+// declarations without a package clause.
+//
+// Only identifiers that resolve to declarations in src are renamed; package
+// names, imported identifiers such as fmt.Println and selectors on values of
+// imported types are left alone, as are init, main and the blank
+// identifier. Exported methods keep their names too: src is checked without
+// loading its imports, so a method such as String, Error or Read may
+// implement an interface of another package (fmt.Stringer, error,
+// io.Reader) that renaming would silently stop it from satisfying. An error
+// is returned when src does not parse, when rename returns an invalid
+// identifier, or when the renamed source has type errors the original did
+// not, such as two names colliding in the same scope.
+//
+// Example:
+//
+//	src := []byte("package p\n\nvar user_name string\n\nfunc get_user() string { return user_name }\n")
+//	out, _ := RenameGoIdentifiers(src, func(s string) string { return CamelCase(s) })
+//	// package p
+//	//
+//	// var userName string
+//	//
+//	// func getUser() string { return userName }
+func RenameGoIdentifiers(src []byte, rename func(string) string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	snippet := false
+	if err != nil {
+		var snippetErr error
+		file, snippetErr = parser.ParseFile(fset, "", snippetPackage+string(src), parser.ParseComments)
+		if snippetErr != nil {
+			return nil, err
+		}
+		snippet = true
+	}
+
+	info, typeErrors := checkGoFile(fset, file)
+	renames := make(map[string]string)
+	for ident, obj := range identObjects(info) {
+		if !renamable(file, obj) {
+			continue
+		}
+		name := rename(ident.Name)
+		if !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("sx: cannot rename %q to %q: not a valid identifier", ident.Name, name)
+		}
+		renames[ident.Name] = name
+		ident.Name = name
+	}
+
+	// Renaming must not introduce type errors such as redeclarations. The
+	// renamed file keeps the original positions, so an error is known when
+	// the original had one at the same position with the same message, or
+	// the same message after renaming the names in it.
+	known := make(map[typeErrorKey]bool, 2*len(typeErrors))
+	for _, err := range typeErrors {
+		key := newTypeErrorKey(err)
+		known[key] = true
+		key.msg = renameWords(key.msg, renames)
+		known[key] = true
+	}
+	var introduced []error
+	_, renamedErrors := checkGoFile(fset, file)
+	for _, err := range renamedErrors {
+		if !known[newTypeErrorKey(err)] {
+			introduced = append(introduced, err)
+		}
+	}
+	if len(introduced) > 0 {
+		return nil, fmt.Errorf("sx: renaming introduces errors: %w", errors.Join(introduced...))
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+	if snippet {
+		out = bytes.TrimPrefix(out, []byte(snippetPackage))
+	}
+	return out, nil
+}
+
+// failingImporter reports every import as unavailable, so files are checked
+// on their own without loading dependencies
+type failingImporter struct{}
+
+// Import implements types.Importer
+func (failingImporter) Import(path string) (*types.Package, error) {
+	return nil, fmt.Errorf("package %s not loaded", path)
+}
+
+// checkGoFile type-checks file in isolation and returns the resolved
+// identifiers and the errors other than failed imports
+func checkGoFile(fset *token.FileSet, file *ast.File) (*types.Info, []error) {
+	info := &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+		Uses: make(map[*ast.Ident]types.Object),
+	}
+	var errs []error
+	config := types.Config{
+		Importer: failingImporter{},
+		Error:    func(err error) { errs = append(errs, err) },
+	}
+	config.Check(file.Name.Name, fset, []*ast.File{file}, info)
+	return info, errs
+}
+
+// typeErrorKey identifies a type error by where it is and what it says
+type typeErrorKey struct {
+	pos token.Pos
+	msg string
+}
+
+// newTypeErrorKey returns the key of err
+func newTypeErrorKey(err error) typeErrorKey {
+	var typeErr types.Error
+	if errors.As(err, &typeErr) {
+		return typeErrorKey{pos: typeErr.Pos, msg: typeErr.Msg}
+	}
+	return typeErrorKey{pos: token.NoPos, msg: err.Error()}
+}
+
+// renameWords replaces the identifiers in msg that are keys of renames
+func renameWords(msg string, renames map[string]string) string {
+	var b strings.Builder
+	start := -1
+	for i, r := range msg + " " {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			word := msg[start:i]
+			if name, ok := renames[word]; ok {
+				word = name
+			}
+			b.WriteString(word)
+			start = -1
+		}
+		if i < len(msg) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// identObjects returns every identifier with the object it defines or uses
+func identObjects(info *types.Info) map[*ast.Ident]types.Object {
+	objects := make(map[*ast.Ident]types.Object, len(info.Defs)+len(info.Uses))
+	for ident, obj := range info.Defs {
+		if obj != nil {
+			objects[ident] = obj
+		}
+	}
+	for ident, obj := range info.Uses {
+		objects[ident] = obj
+	}
+	return objects
+}
+
+// renamable reports whether obj is declared in file and may be renamed
+func renamable(file *ast.File, obj types.Object) bool {
+	if obj.Pos() < file.FileStart || obj.Pos() > file.FileEnd {
+		return false
+	}
+	switch obj := obj.(type) {
+	case *types.PkgName:
+		return false
+	case *types.Func:
+		// Imported interfaces are not loaded, so any exported method, an
+		// interface's included, may implement one of them
+		if obj.Exported() && obj.Signature().Recv() != nil {
+			return false
+		}
+	case *types.Var:
+		// An embedded field is named after its type, which may be imported
+		if obj.Embedded() {
+			t := types.Unalias(obj.Type())
+			if ptr, ok := t.(*types.Pointer); ok {
+				t = types.Unalias(ptr.Elem())
+			}
+			named, ok := t.(*types.Named)
+			return ok && named.Obj().Pkg() == obj.Pkg()
+		}
+	}
+	switch obj.Name() {
+	case "_", "init":
+		return false
+	case "main":
+		_, isFunc := obj.(*types.Func)
+		return !isFunc || obj.Parent() != obj.Pkg().Scope()
+	}
+	return true
+}
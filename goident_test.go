@@ -0,0 +1,235 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestRenameGoIdentifiers(t *testing.T) {
+	camel := func(s string) string { return sx.CamelCase(s) }
+
+	tests := []struct {
+		name     string
+		src      string
+		rename   func(string) string
+		expected string
+	}{
+		{
+			name: "declarations and uses",
+			src: `package p
+
+var user_name string
+
+func get_user(user_id int) string { return user_name }
+`,
+			rename: camel,
+			expected: `package p
+
+var userName string
+
+func getUser(userId int) string { return userName }
+`,
+		},
+		{
+			name: "imports untouched",
+			src: `package p
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type api_client struct {
+	http.Client
+	base_url string ` + "`json:\"base_url\"`" + `
+}
+
+func (c *api_client) do_get() {
+	resp, _ := c.Client.Get(c.base_url)
+	defer resp.Body.Close()
+	fmt.Println(resp)
+}
+`,
+			rename: camel,
+			expected: `package p
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type apiClient struct {
+	http.Client
+	baseUrl string ` + "`json:\"base_url\"`" + `
+}
+
+func (c *apiClient) doGet() {
+	resp, _ := c.Client.Get(c.baseUrl)
+	defer resp.Body.Close()
+	fmt.Println(resp)
+}
+`,
+		},
+		{
+			name: "embedded local type",
+			src: `package p
+
+type base_type struct{ the_id int }
+
+type outer_type struct{ base_type }
+
+var value = outer_type{base_type: base_type{the_id: 1}}.base_type.the_id
+`,
+			rename: camel,
+			expected: `package p
+
+type baseType struct{ theId int }
+
+type outerType struct{ baseType }
+
+var value = outerType{baseType: baseType{theId: 1}}.baseType.theId
+`,
+		},
+		{
+			name: "labels, generics and special functions",
+			src: `package main
+
+func init() {}
+
+func map_values[elem_type any](in []elem_type) {
+outer_loop:
+	for range in {
+		break outer_loop
+	}
+}
+
+func main() { map_values([]int{}) }
+`,
+			rename: camel,
+			expected: `package main
+
+func init() {}
+
+func mapValues[elemType any](in []elemType) {
+outerLoop:
+	for range in {
+		break outerLoop
+	}
+}
+
+func main() { mapValues([]int{}) }
+`,
+		},
+		{
+			name: "exported methods kept",
+			src: `package p
+
+type user_name string
+
+type name_source interface{ Source_name() user_name }
+
+func (u user_name) String() string { return string(u) }
+
+func (u user_name) Error() string { return u.String() }
+
+func (u user_name) Read(buf []byte) (int, error) { return copy(buf, u), nil }
+
+func (u user_name) Source_name() user_name { return u.to_upper() }
+
+func (u user_name) to_upper() user_name { return u }
+
+var _ name_source = user_name("")
+`,
+			rename: camel,
+			expected: `package p
+
+type userName string
+
+type nameSource interface{ Source_name() userName }
+
+func (u userName) String() string { return string(u) }
+
+func (u userName) Error() string { return u.String() }
+
+func (u userName) Read(buf []byte) (int, error) { return copy(buf, u), nil }
+
+func (u userName) Source_name() userName { return u.toUpper() }
+
+func (u userName) toUpper() userName { return u }
+
+var _ nameSource = userName("")
+`,
+		},
+		{
+			name: "existing errors kept",
+			src: `package p
+
+var user_id = missing_value
+
+func get_id() int {
+	unused_id := user_id
+	return user_id
+}
+`,
+			rename: camel,
+			expected: `package p
+
+var userId = missing_value
+
+func getId() int {
+	unusedId := userId
+	return userId
+}
+`,
+		},
+		{
+			name:   "snippet without package clause",
+			src:    "var user_id = 1\n\nfunc get_id() int { return user_id }\n",
+			rename: func(s string) string { return sx.PascalCase(s) },
+			expected: `var UserId = 1
+
+func GetId() int { return UserId }
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.RenameGoIdentifiers([]byte(tt.src), tt.rename)
+			if err != nil {
+				t.Fatalf("RenameGoIdentifiers error = %v", err)
+			}
+			if string(result) != tt.expected {
+				t.Errorf("RenameGoIdentifiers =\n%s\nwant\n%s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenameGoIdentifiers_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		rename func(string) string
+	}{
+		{name: "syntax error", src: "package p\n\nfunc {", rename: func(s string) string { return s }},
+		{name: "collision", src: "package p\n\nvar user_id, userId = 1, 2\n", rename: func(s string) string { return sx.CamelCase(s) }},
+		{name: "invalid identifier", src: "package p\n\nvar user_id = 1\n", rename: func(s string) string { return sx.KebabCase(s) }},
+		{
+			name:   "fixes one error and introduces another",
+			src:    "package p\n\nvar user_id, userId = 1, 2\n\nfunc get_user() {}\n\nfunc f() { getUser(); getUser() }\n",
+			rename: func(s string) string { return sx.CamelCase(s) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result, err := sx.RenameGoIdentifiers([]byte(tt.src), tt.rename); err == nil {
+				t.Errorf("RenameGoIdentifiers(%q) = %q, want error", tt.src, result)
+			}
+		})
+	}
+}
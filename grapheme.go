@@ -0,0 +1,100 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	zeroWidthJoiner = '\u200D'
+	regionalA       = '\U0001F1E6'
+	regionalZ       = '\U0001F1FF'
+)
+
+// isRegionalIndicator reports whether r is one of the flag letter runes
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalA && r <= regionalZ
+}
+
+// isGraphemeExtend reports whether r attaches to the preceding rune
+// (combining marks, variation selectors, emoji modifiers and tag characters)
+func isGraphemeExtend(r rune) bool {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F, r >= 0xE0100 && r <= 0xE01EF:
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return true
+	case r >= 0xE0020 && r <= 0xE007F:
+		return true
+	}
+	return r == zeroWidthJoiner
+}
+
+// nextGrapheme returns the byte length of the grapheme cluster at the start of s.
+// It approximates extended grapheme clusters: CRLF, combining sequences,
+// ZWJ emoji sequences and regional indicator pairs are kept together.
+func nextGrapheme(s string) int {
+	if s == "" {
+		return 0
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if r == '\r' && len(s) > size && s[size] == '\n' {
+		return size + 1
+	}
+
+	n := size
+	if isRegionalIndicator(r) {
+		if next, sz := utf8.DecodeRuneInString(s[n:]); isRegionalIndicator(next) {
+			n += sz
+		}
+	}
+
+	prev := r
+	for n < len(s) {
+		next, sz := utf8.DecodeRuneInString(s[n:])
+		if isGraphemeExtend(next) || prev == zeroWidthJoiner {
+			n += sz
+			prev = next
+			continue
+		}
+		break
+	}
+
+	return n
+}
+
+// graphemeOffsets returns the byte offsets at which each grapheme cluster of s
+// starts, followed by len(s)
+func graphemeOffsets(s string) []int {
+	offsets := make([]int, 0, len(s)+1)
+	for i := 0; i < len(s); {
+		offsets = append(offsets, i)
+		i += nextGrapheme(s[i:])
+	}
+	return append(offsets, len(s))
+}
+
+// runeOffsets returns the byte offsets at which each rune of s starts,
+// followed by len(s)
+func runeOffsets(s string) []int {
+	offsets := make([]int, 0, utf8.RuneCountInString(s)+1)
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+	return append(offsets, len(s))
+}
+
+// splitGraphemes splits s into its grapheme clusters
+func splitGraphemes(s string) []string {
+	offsets := graphemeOffsets(s)
+	clusters := make([]string, len(offsets)-1)
+	for i := range clusters {
+		clusters[i] = s[offsets[i]:offsets[i+1]]
+	}
+	return clusters
+}
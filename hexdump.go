@@ -0,0 +1,88 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HexDumpOption configures HexDump output
+type HexDumpOption func(*HexDumpConfig)
+
+// HexDumpConfig holds the configuration for HexDump
+type HexDumpConfig struct {
+	// Width is the number of bytes shown per line
+	Width int
+	// Group is the number of bytes after which an extra space is inserted; 0 disables grouping
+	Group int
+}
+
+// defaultHexDumpConfig returns the default configuration, matching hexdump -C
+func defaultHexDumpConfig() *HexDumpConfig {
+	return &HexDumpConfig{
+		Width: 16,
+		Group: 8,
+	}
+}
+
+// WithHexWidth sets the number of bytes per line (default 16)
+func WithHexWidth(width int) HexDumpOption {
+	return func(c *HexDumpConfig) {
+		c.Width = width
+	}
+}
+
+// WithHexGroup sets how many bytes form a visual group within a line (default 8)
+func WithHexGroup(group int) HexDumpOption {
+	return func(c *HexDumpConfig) {
+		c.Group = group
+	}
+}
+
+// HexDump returns the canonical offset, hex and ASCII dump of the bytes of s,
+// as printed by hexdump -C. It is useful for spotting invalid UTF-8, invisible
+// characters and normalization differences.
+func HexDump(s string, opts ...HexDumpOption) string {
+	return HexDumpBytes([]byte(s), opts...)
+}
+
+// HexDumpBytes is like HexDump but takes a byte slice
+func HexDumpBytes(data []byte, opts ...HexDumpOption) string {
+	config := defaultHexDumpConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	width := max(config.Width, 1)
+
+	// Width of the hex column for a full line, so the ASCII column always lines up
+	hexWidth := width * 3
+	if config.Group > 0 {
+		hexWidth += (width - 1) / config.Group
+	}
+
+	var result strings.Builder
+	for offset := 0; offset < len(data); offset += width {
+		line := data[offset:min(offset+width, len(data))]
+
+		var hexCol strings.Builder
+		for i, b := range line {
+			if i > 0 && config.Group > 0 && i%config.Group == 0 {
+				hexCol.WriteByte(' ')
+			}
+			fmt.Fprintf(&hexCol, "%02x ", b)
+		}
+
+		fmt.Fprintf(&result, "%08x  %-*s |", offset, hexWidth, hexCol.String())
+		for _, b := range line {
+			if b >= 0x20 && b < 0x7f {
+				result.WriteByte(b)
+			} else {
+				result.WriteByte('.')
+			}
+		}
+		result.WriteString("|\n")
+	}
+
+	return result.String()
+}
@@ -0,0 +1,62 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestHexDump(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.HexDumpOption
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "short line",
+			input:    "hello\n",
+			expected: "00000000  68 65 6c 6c 6f 0a                                 |hello.|\n",
+		},
+		{
+			name:  "invalid utf-8 and combining mark",
+			input: "cafe\u0301\xff",
+			options: []sx.HexDumpOption{
+				sx.WithHexWidth(4),
+				sx.WithHexGroup(0),
+			},
+			expected: "00000000  63 61 66 65  |cafe|\n" +
+				"00000004  cc 81 ff     |...|\n",
+		},
+		{
+			name:     "custom group",
+			input:    "abcdef",
+			options:  []sx.HexDumpOption{sx.WithHexWidth(6), sx.WithHexGroup(2)},
+			expected: "00000000  61 62  63 64  65 66  |abcdef|\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.HexDump(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("HexDump(%q) =\n%s\nwant\n%s", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHexDumpBytes_MatchesCanonical(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog.\x00\x01\x02")
+	if result, expected := sx.HexDumpBytes(data), hex.Dump(data); result != expected {
+		t.Errorf("HexDumpBytes() =\n%s\nwant\n%s", result, expected)
+	}
+}
@@ -0,0 +1,276 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HTMLOption configures how StripHTML treats markup
+type HTMLOption func(*HTMLConfig)
+
+// HTMLConfig holds the configuration for StripHTML
+type HTMLConfig struct {
+	// AllowedTags lists lowercase tag names that are kept (without attributes)
+	AllowedTags []string
+}
+
+// WithAllowedTags keeps the named tags in the output, stripped of attributes,
+// e.g. WithAllowedTags("b", "i", "br")
+func WithAllowedTags(tags ...string) HTMLOption {
+	return func(c *HTMLConfig) {
+		for _, tag := range tags {
+			c.AllowedTags = append(c.AllowedTags, strings.ToLower(tag))
+		}
+	}
+}
+
+// rawTextTags are elements whose content is never displayed as text
+var rawTextTags = []string{"script", "style", "head", "title", "template", "noscript"}
+
+// inlineTags are elements that do not introduce a word break
+var inlineTags = []string{
+	"a", "abbr", "b", "bdi", "bdo", "cite", "code", "data", "dfn", "em", "i", "kbd", "mark",
+	"q", "s", "samp", "small", "span", "strong", "sub", "sup", "time", "u", "var", "!--",
+}
+
+// scanTag parses the tag starting at s[0] == '<' and returns its lowercase
+// name, whether it is a closing tag, and its length in bytes. ok is false
+// when s does not start with markup.
+func scanTag(s string) (name string, closing bool, n int, ok bool) {
+	if len(s) < 2 {
+		return "", false, 0, false
+	}
+
+	switch {
+	case strings.HasPrefix(s, "<!--"):
+		end := strings.Index(s[4:], "-->")
+		if end < 0 {
+			return "!--", false, len(s), true
+		}
+		return "!--", false, end + 7, true
+	case s[1] == '!' || s[1] == '?':
+		end := strings.IndexByte(s, '>')
+		if end < 0 {
+			return "!", false, len(s), true
+		}
+		return "!", false, end + 1, true
+	}
+
+	i := 1
+	if s[1] == '/' {
+		closing = true
+		i++
+	}
+	start := i
+	for i < len(s) && (isASCIIAlnum(s[i]) || s[i] == '-') {
+		i++
+	}
+	if i == start || !unicode.IsLetter(rune(s[start])) {
+		return "", false, 0, false
+	}
+	name = strings.ToLower(s[start:i])
+
+	// Skip attributes, honoring quoted values that may contain '>'
+	var quote byte
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return name, closing, i + 1, true
+		}
+	}
+
+	return name, closing, len(s), true
+}
+
+// indexASCIIFold returns the index of the first ASCII case-insensitive match
+// of substr in s, or -1. Unlike lowercasing s first, byte offsets are preserved.
+func indexASCIIFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// StripHTML converts HTML to plain text: tags and comments are removed,
+// the content of script and style elements is dropped, entities are decoded
+// and whitespace is collapsed to single spaces. Tags listed via
+// WithAllowedTags are kept without attributes, in which case text is
+// re-escaped so the result remains valid HTML.
+func StripHTML(s string, opts ...HTMLOption) string {
+	var result strings.Builder
+	newHTMLStripper(opts).strip(&result, s, true)
+	return result.String()
+}
+
+// htmlStripper holds the state of StripHTML between calls to strip, so that
+// a document can be processed in chunks
+type htmlStripper struct {
+	config *HTMLConfig
+	// space records whitespace that is written before the next output
+	space bool
+	// wrote records whether any output has been written
+	wrote bool
+	// raw is the name of the raw text element whose content is being skipped
+	raw string
+}
+
+// newHTMLStripper returns a stripper configured by opts
+func newHTMLStripper(opts []HTMLOption) *htmlStripper {
+	config := &HTMLConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &htmlStripper{config: config}
+}
+
+// strip writes the plain text of s to result and returns the number of bytes
+// consumed. Unless atEOF is set, a trailing tag, entity or UTF-8 sequence
+// that may continue in the next chunk is left unconsumed.
+func (h *htmlStripper) strip(result *strings.Builder, s string, atEOF bool) int {
+	i := 0
+	for i < len(s) {
+		if h.raw != "" {
+			closing := "</" + h.raw
+			end := indexASCIIFold(s[i:], closing)
+			if end < 0 {
+				if atEOF {
+					return len(s)
+				}
+				// Keep a possible partial closing tag for the next chunk
+				return max(i, len(s)-len(closing)+1)
+			}
+			i += end
+			h.raw = ""
+			continue
+		}
+
+		if s[i] != '<' {
+			end := strings.IndexByte(s[i:], '<')
+			if end < 0 {
+				end = len(s)
+				if !atEOF {
+					end = textBoundary(s, i)
+				}
+			} else {
+				end += i
+			}
+			h.text(result, s[i:end])
+			if end < len(s) && s[end] != '<' {
+				return end
+			}
+			i = end
+			continue
+		}
+
+		name, closing, n, ok := scanTag(s[i:])
+		if !atEOF && (!ok && len(s)-i <= 2 || ok && !tagTerminated(s[i:], n)) {
+			return i
+		}
+		if !ok {
+			h.text(result, "<")
+			i++
+			continue
+		}
+		i += n
+		h.tag(result, name, closing)
+	}
+	return i
+}
+
+// text writes decoded text, collapsing whitespace
+func (h *htmlStripper) text(result *strings.Builder, s string) {
+	for _, r := range html.UnescapeString(s) {
+		if unicode.IsSpace(r) {
+			h.space = true
+			continue
+		}
+		h.separate(result)
+		if len(h.config.AllowedTags) > 0 {
+			result.WriteString(html.EscapeString(string(r)))
+		} else {
+			result.WriteRune(r)
+		}
+	}
+}
+
+// tag handles an opening or closing tag
+func (h *htmlStripper) tag(result *strings.Builder, name string, closing bool) {
+	if !closing && slices.Contains(rawTextTags, name) {
+		h.raw = name
+		return
+	}
+
+	// Tags separate words even when no whitespace surrounds them in the source
+	if !slices.Contains(inlineTags, name) {
+		h.space = true
+	}
+
+	if slices.Contains(h.config.AllowedTags, name) {
+		h.separate(result)
+		result.WriteByte('<')
+		if closing {
+			result.WriteByte('/')
+		}
+		result.WriteString(name)
+		result.WriteByte('>')
+	}
+}
+
+// separate writes pending whitespace as a single space, unless nothing has
+// been written yet
+func (h *htmlStripper) separate(result *strings.Builder) {
+	if h.space && h.wrote {
+		result.WriteByte(' ')
+	}
+	h.space = false
+	h.wrote = true
+}
+
+// tagTerminated reports whether the tag of length n scanned at the start of s
+// is complete, rather than running into the end of s
+func tagTerminated(s string, n int) bool {
+	if n < len(s) {
+		return true
+	}
+	// An unterminated tag extends to the end of any longer input
+	_, _, m, _ := scanTag(s + "\x00")
+	return m == n
+}
+
+// maxEntityLen is the length of the longest named HTML character reference
+const maxEntityLen = 33
+
+// textBoundary returns the end of the text starting at s[i] that can be
+// decoded without the next chunk: a trailing character reference or UTF-8
+// sequence that may be incomplete is excluded
+func textBoundary(s string, i int) int {
+	end := len(s)
+	if amp := strings.LastIndexByte(s[i:], '&'); amp >= 0 && len(s)-i-amp <= maxEntityLen {
+		ref := s[i+amp+1:]
+		if strings.IndexFunc(ref, func(r rune) bool { return r > unicode.MaxASCII || !isASCIIAlnum(byte(r)) && r != '#' }) < 0 {
+			end = i + amp
+		}
+	}
+	for k := end - 1; k >= i && k >= end-utf8.UTFMax; k-- {
+		if utf8.RuneStart(s[k]) {
+			if !utf8.FullRuneInString(s[k:end]) {
+				end = k
+			}
+			break
+		}
+	}
+	return end
+}
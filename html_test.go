@@ -0,0 +1,85 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestStripHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.HTMLOption
+		expected string
+	}{
+		{
+			name:     "simple tags",
+			input:    "<p>Hello <b>world</b>!</p>",
+			expected: "Hello world!",
+		},
+		{
+			name:     "block elements separate words",
+			input:    "<div>one</div><div>two</div><br/>three",
+			expected: "one two three",
+		},
+		{
+			name:     "inline elements do not",
+			input:    "un<em>believ</em>able",
+			expected: "unbelievable",
+		},
+		{
+			name:     "entities",
+			input:    "Fish &amp; Chips &lt;3 &eacute;t&eacute; &#8364;5",
+			expected: "Fish & Chips <3 été €5",
+		},
+		{
+			name:     "whitespace collapsed",
+			input:    "  <p>\n\tlots   of\n space </p>  ",
+			expected: "lots of space",
+		},
+		{
+			name:     "script and style dropped",
+			input:    "<style>p{color:red}</style>text<script>alert('<b>')</script> more",
+			expected: "text more",
+		},
+		{
+			name:     "comments",
+			input:    "a<!-- hidden <b>bold</b> -->b",
+			expected: "ab",
+		},
+		{
+			name:     "attributes with angle brackets",
+			input:    `<a href="x" title="a > b">link</a>`,
+			expected: "link",
+		},
+		{
+			name:     "literal less-than",
+			input:    "1 < 2 and 3 <4",
+			expected: "1 < 2 and 3 <4",
+		},
+		{
+			name:     "allowlist keeps tags without attributes",
+			input:    `<p class="x">Hello <b onclick="evil()">bold</b> <i>it</i> <u>u</u></p>`,
+			options:  []sx.HTMLOption{sx.WithAllowedTags("b", "I")},
+			expected: "Hello <b>bold</b> <i>it</i> u",
+		},
+		{
+			name:     "allowlist re-escapes text",
+			input:    "<b>1 &lt; 2</b>",
+			options:  []sx.HTMLOption{sx.WithAllowedTags("b")},
+			expected: "<b>1 &lt; 2</b>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.StripHTML(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("StripHTML(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
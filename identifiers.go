@@ -0,0 +1,84 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IdentOption configures how ExtractIdentifiers filters tokens
+type IdentOption func(*IdentConfig)
+
+// IdentConfig holds the configuration for ExtractIdentifiers
+type IdentConfig struct {
+	// MinLength is the minimum identifier length in runes
+	MinLength int
+	// Keywords are excluded from the result
+	Keywords []string
+}
+
+// WithMinLength skips identifiers shorter than n runes
+func WithMinLength(n int) IdentOption {
+	return func(c *IdentConfig) {
+		c.MinLength = n
+	}
+}
+
+// WithKeywords excludes the given words (e.g. language keywords) from the result
+func WithKeywords(keywords ...string) IdentOption {
+	return func(c *IdentConfig) {
+		c.Keywords = append(c.Keywords, keywords...)
+	}
+}
+
+// isIdentStart reports whether r can begin an identifier
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+// isIdentPart reports whether r can continue an identifier
+func isIdentPart(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// ExtractIdentifiers scans arbitrary source code or text and returns the
+// identifier-like tokens (a letter or underscore followed by letters, digits
+// or underscores) with their byte offsets. Runs starting with a digit, such as
+// 42px, are treated as numbers and skipped.
+func ExtractIdentifiers(src string, opts ...IdentOption) []Match {
+	config := &IdentConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	var matches []Match
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		if !isIdentPart(r) {
+			i += size
+			continue
+		}
+
+		start := i
+		runes := 0
+		for i < len(src) {
+			r, size := utf8.DecodeRuneInString(src[i:])
+			if !isIdentPart(r) {
+				break
+			}
+			i += size
+			runes++
+		}
+
+		first, _ := utf8.DecodeRuneInString(src[start:])
+		ident := src[start:i]
+		if !isIdentStart(first) || runes < config.MinLength || slices.Contains(config.Keywords, ident) {
+			continue
+		}
+		matches = append(matches, Match{Value: ident, Start: start, End: i})
+	}
+
+	return matches
+}
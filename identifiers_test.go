@@ -0,0 +1,68 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestExtractIdentifiers(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.IdentOption
+		expected []string
+	}{
+		{
+			name:     "go snippet",
+			input:    "func getUserID(ctx context.Context) error { return nil }",
+			expected: []string{"func", "getUserID", "ctx", "context", "Context", "error", "return", "nil"},
+		},
+		{
+			name:     "keywords filtered",
+			input:    "func getUserID(ctx context.Context) error { return nil }",
+			options:  []sx.IdentOption{sx.WithKeywords("func", "return", "nil", "error")},
+			expected: []string{"getUserID", "ctx", "context", "Context"},
+		},
+		{
+			name:     "min length",
+			input:    "for i := 0; i < max_len; i++ { x = y }",
+			options:  []sx.IdentOption{sx.WithMinLength(3)},
+			expected: []string{"for", "max_len"},
+		},
+		{
+			name:     "numbers skipped",
+			input:    "width: 42px; v2 _private 3d",
+			expected: []string{"width", "v2", "_private"},
+		},
+		{
+			name:     "unicode",
+			input:    "größe = naïveValue",
+			expected: []string{"größe", "naïveValue"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchValues(sx.ExtractIdentifiers(tt.input, tt.options...))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ExtractIdentifiers(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExtractIdentifiers_Positions(t *testing.T) {
+	input := "a.userName = 1"
+	expected := []sx.Match{
+		{Value: "a", Start: 0, End: 1},
+		{Value: "userName", Start: 2, End: 10},
+	}
+
+	if result := sx.ExtractIdentifiers(input); !reflect.DeepEqual(result, expected) {
+		t.Errorf("ExtractIdentifiers(%q) = %v, want %v", input, result, expected)
+	}
+}
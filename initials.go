@@ -0,0 +1,75 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// nameParticles are lowercase name particles and suffixes that Initials skips
+var nameParticles = map[string]bool{
+	"al": true, "bin": true, "da": true, "das": true, "de": true, "del": true,
+	"della": true, "den": true, "der": true, "di": true, "do": true, "dos": true,
+	"du": true, "el": true, "ibn": true, "la": true, "le": true, "ter": true,
+	"van": true, "von": true, "y": true, "zu": true,
+	"jr": true, "sr": true, "ii": true, "iii": true, "iv": true, "phd": true, "md": true,
+}
+
+// Initials returns up to max uppercase initials for a personal name, as used
+// for avatar placeholders. Name particles such as "van der" or "de la" and
+// suffixes such as "Jr." are skipped, and each initial is a whole grapheme
+// cluster so accented letters and emoji are never split. When the name has
+// more initials than max, the first and last are kept; max <= 0 keeps them all.
+//
+// Example:
+//
+//	Initials("Maria van der Berg", 2) // "MB"
+//	Initials("Ada King Lovelace", 2)  // "AL"
+//	Initials("Ada King Lovelace", 3)  // "AKL"
+func Initials(name string, max int) string {
+	words := strings.Fields(name)
+	var initials []string
+	for _, word := range words {
+		key := strings.ToLower(strings.Trim(word, ".,"))
+		if nameParticles[key] {
+			continue
+		}
+		if initial := initialOf(word); initial != "" {
+			initials = append(initials, initial)
+		}
+	}
+
+	// A name made only of particles still deserves initials
+	if len(initials) == 0 {
+		for _, word := range words {
+			if initial := initialOf(word); initial != "" {
+				initials = append(initials, initial)
+			}
+		}
+	}
+
+	if max > 0 && len(initials) > max {
+		if max == 1 {
+			initials = initials[:1]
+		} else {
+			initials = append(initials[:max-1], initials[len(initials)-1])
+		}
+	}
+	return strings.Join(initials, "")
+}
+
+// initialOf returns the uppercased first grapheme cluster of word that starts
+// with a letter or digit, skipping leading punctuation
+func initialOf(word string) string {
+	for i := 0; i < len(word); {
+		size := nextGrapheme(word[i:])
+		r, _ := utf8.DecodeRuneInString(word[i:])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.So, r) {
+			return strings.ToUpper(word[i : i+size])
+		}
+		i += size
+	}
+	return ""
+}
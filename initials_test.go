@@ -0,0 +1,43 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		expected string
+	}{
+		{name: "particles", input: "Maria van der Berg", max: 2, expected: "MB"},
+		{name: "two words", input: "ada lovelace", max: 2, expected: "AL"},
+		{name: "first and last kept", input: "John Ronald Reuel Tolkien", max: 2, expected: "JT"},
+		{name: "three", input: "John Ronald Reuel Tolkien", max: 3, expected: "JRT"},
+		{name: "no limit", input: "John Ronald Reuel Tolkien", max: 0, expected: "JRRT"},
+		{name: "one", input: "Grace Hopper", max: 1, expected: "G"},
+		{name: "suffix", input: "Martin Luther King Jr.", max: 2, expected: "MK"},
+		{name: "spanish", input: "Juan de la Cruz", max: 2, expected: "JC"},
+		{name: "hyphenated", input: "Jean-Luc Picard", max: 2, expected: "JP"},
+		{name: "accented", input: "\u00e9mile zola", max: 2, expected: "\u00c9Z"},
+		{name: "combining mark", input: "e\u0301mile zola", max: 2, expected: "E\u0301Z"},
+		{name: "punctuation", input: "(Bob) 'Smith'", max: 2, expected: "BS"},
+		{name: "cjk", input: "张 伟", max: 2, expected: "张伟"},
+		{name: "only particles", input: "de la", max: 2, expected: "DL"},
+		{name: "empty", input: "  ", max: 2, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Initials(tt.input, tt.max)
+			if result != tt.expected {
+				t.Errorf("Initials(%q, %d) = %q, want %q", tt.input, tt.max, result, tt.expected)
+			}
+		})
+	}
+}
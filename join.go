@@ -0,0 +1,88 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// JoinOption configures JoinHuman
+type JoinOption func(*JoinConfig)
+
+// JoinConfig holds the configuration for JoinHuman
+type JoinConfig struct {
+	// Conjunction joins the last item, such as "and" or "or"
+	Conjunction string
+	// OxfordComma places a comma before the conjunction in lists of three or more
+	OxfordComma bool
+	// Limit is the maximum number of items listed before the rest are
+	// summarized as "and N more"; 0 lists every item
+	Limit int
+}
+
+// defaultJoinConfig returns the default configuration for JoinHuman
+func defaultJoinConfig() *JoinConfig {
+	return &JoinConfig{
+		Conjunction: "and",
+		OxfordComma: true,
+	}
+}
+
+// WithConjunction sets the word joining the last item (default "and")
+func WithConjunction(conjunction string) JoinOption {
+	return func(c *JoinConfig) {
+		c.Conjunction = conjunction
+	}
+}
+
+// WithOxfordComma sets whether a comma precedes the conjunction (default true)
+func WithOxfordComma(enabled bool) JoinOption {
+	return func(c *JoinConfig) {
+		c.OxfordComma = enabled
+	}
+}
+
+// WithLimit lists at most n items and summarizes the remainder as "N more"
+func WithLimit(n int) JoinOption {
+	return func(c *JoinConfig) {
+		c.Limit = n
+	}
+}
+
+// JoinHuman joins items into an English list for display
+//
+// Example:
+//
+//	JoinHuman([]string{"a", "b", "c"})                         // "a, b, and c"
+//	JoinHuman([]string{"a", "b"}, WithConjunction("or"))       // "a or b"
+//	JoinHuman([]string{"a", "b", "c", "d", "e"}, WithLimit(2)) // "a, b, and 3 more"
+func JoinHuman(items []string, opts ...JoinOption) string {
+	config := defaultJoinConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	if config.Limit > 0 && len(items) > config.Limit {
+		rest := strconv.Itoa(len(items)-config.Limit) + " more"
+		items = append(items[:config.Limit:config.Limit], rest)
+	}
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " " + config.Conjunction + " " + items[1]
+	}
+
+	last := len(items) - 1
+	var b strings.Builder
+	b.WriteString(strings.Join(items[:last], ", "))
+	if config.OxfordComma {
+		b.WriteByte(',')
+	}
+	b.WriteString(" " + config.Conjunction + " " + items[last])
+	return b.String()
+}
@@ -0,0 +1,45 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestJoinHuman(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []string
+		options  []sx.JoinOption
+		expected string
+	}{
+		{name: "empty", items: nil, expected: ""},
+		{name: "one", items: []string{"a"}, expected: "a"},
+		{name: "two", items: []string{"a", "b"}, expected: "a and b"},
+		{name: "three", items: []string{"a", "b", "c"}, expected: "a, b, and c"},
+		{name: "no oxford comma", items: []string{"a", "b", "c"}, options: []sx.JoinOption{sx.WithOxfordComma(false)}, expected: "a, b and c"},
+		{name: "conjunction", items: []string{"a", "b", "c"}, options: []sx.JoinOption{sx.WithConjunction("or")}, expected: "a, b, or c"},
+		{name: "limit", items: []string{"a", "b", "c", "d", "e"}, options: []sx.JoinOption{sx.WithLimit(2)}, expected: "a, b, and 3 more"},
+		{name: "limit one", items: []string{"a", "b", "c"}, options: []sx.JoinOption{sx.WithLimit(1)}, expected: "a and 2 more"},
+		{name: "limit not reached", items: []string{"a", "b"}, options: []sx.JoinOption{sx.WithLimit(2)}, expected: "a and b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.JoinHuman(tt.items, tt.options...)
+			if result != tt.expected {
+				t.Errorf("JoinHuman(%q) = %q, want %q", tt.items, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJoinHuman_DoesNotModifyInput(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	sx.JoinHuman(items, sx.WithLimit(2))
+	if items[2] != "c" {
+		t.Errorf("JoinHuman modified its input: %q", items)
+	}
+}
@@ -0,0 +1,97 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"math"
+	"slices"
+	"unicode"
+)
+
+// keyPos is the position of a key, in key widths from the top left
+type keyPos struct {
+	x, y float64
+}
+
+// Layout describes the physical positions of keys on a keyboard, used to
+// weight typos by how close the keys involved are. The zero Layout knows no
+// keys, so every substitution other than a change of case costs a full edit.
+type Layout struct {
+	keys map[rune]keyPos
+}
+
+// rowStagger is the horizontal offset of each row on a standard staggered keyboard
+var rowStagger = []float64{0, 0.5, 0.75, 1.25}
+
+// NewLayout builds a Layout from rows of keys, top to bottom, such as
+// "1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm". Rows are staggered like a
+// standard keyboard. Keys are matched case-insensitively.
+func NewLayout(rows ...string) Layout {
+	l := Layout{keys: make(map[rune]keyPos)}
+	for y, row := range rows {
+		offset := rowStagger[min(y, len(rowStagger)-1)]
+		x := 0
+		for _, r := range row {
+			l.keys[unicode.ToLower(r)] = keyPos{x: offset + float64(x), y: float64(y)}
+			x++
+		}
+	}
+	return l
+}
+
+// Common keyboard layouts
+var (
+	QWERTY = NewLayout("1234567890-=", "qwertyuiop[]", "asdfghjkl;'", "zxcvbnm,./")
+	QWERTZ = NewLayout("1234567890ß", "qwertzuiopü+", "asdfghjklöä#", "yxcvbnm,.-")
+	AZERTY = NewLayout("&é\"'(-è_çà)=", "azertyuiop^$", "qsdfghjklmù*", "wxcvbn,;:!")
+)
+
+// keyDistance returns the distance between the keys for x and y in key
+// widths, or false if either key is not in the layout
+func (l Layout) keyDistance(x, y rune) (float64, bool) {
+	px, okx := l.keys[unicode.ToLower(x)]
+	py, oky := l.keys[unicode.ToLower(y)]
+	if !okx || !oky {
+		return 0, false
+	}
+	return math.Hypot(px.x-py.x, px.y-py.y), true
+}
+
+// neighbors returns the keys adjacent to r, including diagonally, in rune order
+func (l Layout) neighbors(r rune) []rune {
+	var keys []rune
+	for k := range l.keys {
+		if d, ok := l.keyDistance(r, k); ok && d > 0 && d < 1.5 {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// substitutionCost charges less for replacing a key with a nearby one: half
+// an edit for adjacent keys, rising to a full edit for keys two or more apart
+func (l Layout) substitutionCost(x, y rune) float64 {
+	if unicode.ToLower(x) == unicode.ToLower(y) {
+		return 0.5
+	}
+	d, ok := l.keyDistance(x, y)
+	if !ok {
+		return 1
+	}
+	return min(1, d/2)
+}
+
+// KeyboardDistance is an edit distance between a and b in which substituting
+// a character typed with a neighboring key costs about half an edit, so
+// fat-finger typos rank closer than unrelated misspellings. Insertions and
+// deletions cost 1, as do substitutions involving keys not in layout, and a
+// change of case costs half an edit.
+//
+// Example:
+//
+//	KeyboardDistance("hello", "hwllo", QWERTY) // 0.5 (w is next to e)
+//	KeyboardDistance("hello", "hpllo", QWERTY) // 1
+func KeyboardDistance(a, b string, layout Layout) float64 {
+	return weightedLevenshtein([]rune(a), []rune(b), layout.substitutionCost)
+}
@@ -0,0 +1,51 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestKeyboardDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		layout   sx.Layout
+		expected float64
+	}{
+		{name: "identical", a: "hello", b: "hello", layout: sx.QWERTY, expected: 0},
+		{name: "adjacent key", a: "hello", b: "hwllo", layout: sx.QWERTY, expected: 0.5},
+		{name: "distant key", a: "hello", b: "hpllo", layout: sx.QWERTY, expected: 1},
+		{name: "two keys apart", a: "cat", b: "cau", layout: sx.QWERTY, expected: 1},
+		{name: "diagonal neighbor", a: "cat", b: "cag", layout: sx.QWERTY, expected: 0.5153882032022076},
+		{name: "case change", a: "Go", b: "go", layout: sx.QWERTY, expected: 0.5},
+		{name: "insertion", a: "helo", b: "hello", layout: sx.QWERTY, expected: 1},
+		{name: "unknown keys", a: "日本", b: "日木", layout: sx.QWERTY, expected: 1},
+		{name: "azerty neighbors", a: "a", b: "z", layout: sx.AZERTY, expected: 0.5},
+		{name: "qwerty not neighbors", a: "a", b: "z", layout: sx.QWERTY, expected: 0.5590169943749475},
+		{name: "qwertz", a: "z", b: "u", layout: sx.QWERTZ, expected: 0.5},
+		{name: "zero layout", a: "kitten", b: "sitting", layout: sx.Layout{}, expected: 3},
+		{name: "custom layout", a: "ab", b: "ac", layout: sx.NewLayout("abc"), expected: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.KeyboardDistance(tt.a, tt.b, tt.layout)
+			if result != tt.expected {
+				t.Errorf("KeyboardDistance(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKeyboardDistance_RanksFatFingerTypos(t *testing.T) {
+	// "hrllo" and "hpllo" are both one substitution from "hello", but only
+	// the first is a plausible fat-finger typo of it
+	near := sx.KeyboardDistance("hello", "hrllo", sx.QWERTY)
+	far := sx.KeyboardDistance("hello", "hpllo", sx.QWERTY)
+	if near >= far {
+		t.Errorf("adjacent-key typo distance %v not less than distant-key %v", near, far)
+	}
+}
@@ -0,0 +1,262 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// KeysOption configures ConvertKeys and ConvertJSONKeys
+type KeysOption func(*KeysConfig)
+
+// KeysConfig holds the configuration for ConvertKeys and ConvertJSONKeys
+type KeysConfig struct {
+	// ExcludedPaths are the key paths whose keys are kept as they are, see
+	// WithExcludedPaths
+	ExcludedPaths []string
+	// CaseOptions are passed to the key converter
+	CaseOptions []Option
+}
+
+// defaultKeysConfig returns the default configuration
+func defaultKeysConfig() *KeysConfig {
+	return &KeysConfig{}
+}
+
+// WithExcludedPaths keeps the keys at the given paths, and every key below
+// them, as they are. A path lists the original keys from the top level down,
+// joined by dots, with arrays left out: "metadata.labels" matches the labels
+// object of metadata, also when metadata is an array of objects. Use it for
+// objects whose keys are data rather than field names.
+func WithExcludedPaths(paths ...string) KeysOption {
+	return func(c *KeysConfig) {
+		c.ExcludedPaths = append(c.ExcludedPaths, paths...)
+	}
+}
+
+// WithCaseOptions sets options passed to the key converter
+func WithCaseOptions(opts ...Option) KeysOption {
+	return func(c *KeysConfig) {
+		c.CaseOptions = append(c.CaseOptions, opts...)
+	}
+}
+
+// newKeysConfig returns the configuration built from opts
+func newKeysConfig(opts []KeysOption) *KeysConfig {
+	config := defaultKeysConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// ConvertKeys returns a copy of v with the keys of every nested
+// map[string]any, including those inside []any, converted with convert.
+// Other values are shared with v, which is never modified. Keys of a map that
+// convert to the same name, such as "userId" and "user_id" with SnakeCase,
+// return an error wrapping ErrDuplicateKey, like ConvertJSONKeys.
+//
+// Example:
+//
+//	ConvertKeys(map[string]any{"userId": 1, "tags": []any{map[string]any{"tagName": "a"}}}, SnakeCase)
+//	// map[tags:[map[tag_name:a]] user_id:1], <nil>
+func ConvertKeys(v any, convert func(s string, opts ...Option) string, opts ...KeysOption) (any, error) {
+	config := newKeysConfig(opts)
+	return config.convertKeys(v, "", convert)
+}
+
+// convertKeys converts the keys of v, which is found at path
+func (c *KeysConfig) convertKeys(v any, path string, convert func(s string, opts ...Option) string) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		converted := make(map[string]any, len(v))
+		// from maps the converted keys to the keys they came from
+		from := make(map[string]string, len(v))
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			keyPath := joinKeyPath(path, key)
+			name := key
+			if !c.excluded(keyPath) {
+				name = convert(key, c.CaseOptions...)
+			}
+			if original, ok := from[name]; ok {
+				return nil, fmt.Errorf("%w: %q and %q both convert to %q", ErrDuplicateKey, original, key, name)
+			}
+			from[name] = key
+			if c.excluded(keyPath) {
+				converted[name] = v[key]
+				continue
+			}
+			value, err := c.convertKeys(v[key], keyPath, convert)
+			if err != nil {
+				return nil, err
+			}
+			converted[name] = value
+		}
+		return converted, nil
+	case []any:
+		converted := make([]any, len(v))
+		for i, elem := range v {
+			value, err := c.convertKeys(elem, path, convert)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = value
+		}
+		return converted, nil
+	default:
+		return v, nil
+	}
+}
+
+// ConvertJSONKeys converts the object keys of the JSON document data with
+// convert, keeping their order, and returns the document written compactly.
+// Values keep their meaning and numbers their exact digits, but strings are
+// re-encoded, so an escape such as \u00e9 is written as the character it
+// stands for. Excluded values are copied verbatim. Malformed JSON is reported
+// with the error of the encoding/json package, and data after the document
+// with a *SyntaxError. Keys of an object that convert to the same name, such
+// as "userId" and "user_id" with SnakeCase, return an error wrapping
+// ErrDuplicateKey rather than a document with duplicate keys.
+//
+// Example:
+//
+//	ConvertJSONKeys([]byte(`{"userId":1,"homeAddress":{"zipCode":"02134"}}`), SnakeCase)
+//	// {"user_id":1,"home_address":{"zip_code":"02134"}}
+func ConvertJSONKeys(data []byte, convert func(s string, opts ...Option) string, opts ...KeysOption) ([]byte, error) {
+	return ConvertJSONKeysContext(context.Background(), data, convert, opts...)
+}
+
+// ConvertJSONKeysContext is like ConvertJSONKeys, but returns early with the
+// context's error if ctx is done before the document is rewritten
+func ConvertJSONKeysContext(ctx context.Context, data []byte, convert func(s string, opts ...Option) string, opts ...KeysOption) ([]byte, error) {
+	config := newKeysConfig(opts)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+	if err := config.rewriteJSONKeys(ctx, dec, &buf, "", convert); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, &SyntaxError{Msg: "data after top-level JSON value", Offset: int(dec.InputOffset())}
+	}
+	return buf.Bytes(), nil
+}
+
+// rewriteJSONKeys copies the next JSON value, found at path, from dec to buf
+// compactly, converting object keys
+func (c *KeysConfig) rewriteJSONKeys(ctx context.Context, dec *json.Decoder, buf *bytes.Buffer, path string, convert func(s string, opts ...Option) string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return writeJSON(buf, token)
+	}
+
+	buf.WriteRune(rune(delim))
+	// seen maps the keys written to this object to the keys they came from
+	var seen map[string]string
+	if delim == '{' {
+		seen = make(map[string]string)
+	}
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if delim == '{' {
+			token, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key := token.(string)
+			keyPath := joinKeyPath(path, key)
+			name := key
+			if !c.excluded(keyPath) {
+				name = convert(key, c.CaseOptions...)
+			}
+			if original, ok := seen[name]; ok && original != key {
+				return fmt.Errorf("%w: %q and %q both convert to %q", ErrDuplicateKey, original, key, name)
+			}
+			seen[name] = key
+			if c.excluded(keyPath) {
+				// Copy the value verbatim, its keys included
+				var raw json.RawMessage
+				if err := dec.Decode(&raw); err != nil {
+					return err
+				}
+				if err := writeJSON(buf, key); err != nil {
+					return err
+				}
+				buf.WriteByte(':')
+				if err := json.Compact(buf, raw); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := writeJSON(buf, name); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := c.rewriteJSONKeys(ctx, dec, buf, keyPath, convert); err != nil {
+				return unexpectedEOF(err)
+			}
+			continue
+		}
+		if err := c.rewriteJSONKeys(ctx, dec, buf, path, convert); err != nil {
+			return unexpectedEOF(err)
+		}
+	}
+	// Consume the closing delimiter
+	end, err := dec.Token()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	buf.WriteRune(rune(end.(json.Delim)))
+	return nil
+}
+
+// excluded reports whether the keys at path are kept as they are
+func (c *KeysConfig) excluded(path string) bool {
+	return slices.Contains(c.ExcludedPaths, path)
+}
+
+// joinKeyPath returns the path of key inside the object at path
+func joinKeyPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// writeJSON writes the JSON encoding of a scalar without escaping HTML
+// characters, so values are copied as they were written
+func writeJSON(buf *bytes.Buffer, v any) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	buf.Truncate(buf.Len() - 1) // Encode appends a newline
+	return nil
+}
+
+// unexpectedEOF reports an end of input inside a value as an error
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
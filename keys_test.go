@@ -0,0 +1,157 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestConvertKeys(t *testing.T) {
+	input := map[string]any{
+		"userId": 1,
+		"tags":   []any{map[string]any{"tagName": "a"}, "plainValue"},
+		"metadata": map[string]any{
+			"createdAt": "2024",
+			"labels":    map[string]any{"appName": "web"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    any
+		convert  func(string, ...sx.Option) string
+		options  []sx.KeysOption
+		expected any
+		wantErr  bool
+	}{
+		{
+			name:    "nested",
+			input:   input,
+			convert: sx.SnakeCase[string],
+			expected: map[string]any{
+				"user_id": 1,
+				"tags":    []any{map[string]any{"tag_name": "a"}, "plainValue"},
+				"metadata": map[string]any{
+					"created_at": "2024",
+					"labels":     map[string]any{"app_name": "web"},
+				},
+			},
+		},
+		{
+			name:    "excluded path",
+			input:   input,
+			convert: sx.SnakeCase[string],
+			options: []sx.KeysOption{sx.WithExcludedPaths("metadata.labels")},
+			expected: map[string]any{
+				"user_id": 1,
+				"tags":    []any{map[string]any{"tag_name": "a"}, "plainValue"},
+				"metadata": map[string]any{
+					"created_at": "2024",
+					"labels":     map[string]any{"appName": "web"},
+				},
+			},
+		},
+		{
+			name:     "path through array",
+			input:    []any{map[string]any{"itemList": map[string]any{"keepMe": true}}},
+			convert:  sx.PascalCase[string],
+			options:  []sx.KeysOption{sx.WithExcludedPaths("itemList")},
+			expected: []any{map[string]any{"itemList": map[string]any{"keepMe": true}}},
+		},
+		{
+			name:     "case options",
+			input:    map[string]any{"user_id": 1},
+			convert:  sx.CamelCase[string],
+			options:  []sx.KeysOption{sx.WithCaseOptions(sx.WithAcronyms("ID"))},
+			expected: map[string]any{"userID": 1},
+		},
+		{name: "collision", input: map[string]any{"user_id": 1, "userId": 2}, convert: sx.CamelCase[string], wantErr: true},
+		{name: "nested collision", input: []any{map[string]any{"a": map[string]any{"fooBar": 1, "FooBar": 2}}}, convert: sx.SnakeCase[string], wantErr: true},
+		{name: "collision with excluded key", input: map[string]any{"aB": 1, "a_b": 2}, convert: sx.SnakeCase[string], options: []sx.KeysOption{sx.WithExcludedPaths("a_b")}, wantErr: true},
+		{name: "scalar", input: "fooBar", convert: sx.SnakeCase[string], expected: "fooBar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.ConvertKeys(tt.input, tt.convert, tt.options...)
+			if tt.wantErr {
+				if !errors.Is(err, sx.ErrDuplicateKey) || result != nil {
+					t.Errorf("ConvertKeys() = %v, %v, want nil, sx.ErrDuplicateKey", result, err)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ConvertKeys() = %v, %v, want %v", result, err, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := input["userId"]; !ok {
+		t.Errorf("ConvertKeys() modified its input")
+	}
+}
+
+func TestConvertJSONKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.KeysOption
+		expected string
+		wantErr  bool
+	}{
+		{name: "nested", input: `{"userId":1,"homeAddress":{"zipCode":"02134"}}`, expected: `{"user_id":1,"home_address":{"zip_code":"02134"}}`},
+		{name: "order kept", input: `{"zKey":1,"aKey":2}`, expected: `{"z_key":1,"a_key":2}`},
+		{name: "arrays of objects", input: `[{"itemId":1},{"itemId":2},[{"deepKey":null}]]`, expected: `[{"item_id":1},{"item_id":2},[{"deep_key":null}]]`},
+		{name: "values unchanged", input: `{"bigNum": 12345678901234567890, "htmlText": "<b>&</b>", "someKey": "camelValue"}`, expected: `{"big_num":12345678901234567890,"html_text":"<b>&</b>","some_key":"camelValue"}`},
+		{
+			name:     "excluded path",
+			input:    `{"metaData":{"labelMap":{"appName":"web", "tierName": "front"}},"itemList":[{"labelMap":{"aB":1}}]}`,
+			options:  []sx.KeysOption{sx.WithExcludedPaths("metaData.labelMap", "itemList.labelMap")},
+			expected: `{"meta_data":{"labelMap":{"appName":"web","tierName":"front"}},"item_list":[{"labelMap":{"aB":1}}]}`,
+		},
+		{name: "scalar", input: `"fooBar"`, expected: `"fooBar"`},
+		{name: "empty object", input: ` {} `, expected: `{}`},
+		{name: "truncated", input: `{"a":`, wantErr: true},
+		{name: "empty", input: ``, wantErr: true},
+		{name: "trailing data", input: `{} {}`, wantErr: true},
+		{name: "malformed", input: `{"a" 1}`, wantErr: true},
+		{name: "colliding keys", input: `{"userId":1,"user_id":2}`, wantErr: true},
+		{name: "colliding nested keys", input: `[{"a":{"fooBar":1,"FooBar":2}}]`, wantErr: true},
+		{name: "colliding with excluded key", input: `{"aB":{},"a_b":1}`, options: []sx.KeysOption{sx.WithExcludedPaths("a_b")}, wantErr: true},
+		{name: "escapes re-encoded", input: `{"aB":"caf\u00e9 \/"}`, expected: "{\"a_b\":\"caf\u00e9 /\"}"},
+		{name: "same key in sibling objects", input: `[{"userId":1},{"user_id":2}]`, expected: `[{"user_id":1},{"user_id":2}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.ConvertJSONKeys([]byte(tt.input), sx.SnakeCase, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertJSONKeys(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if string(result) != tt.expected {
+				t.Errorf("ConvertJSONKeys(%q) = %s, want %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	var syntaxErr *sx.SyntaxError
+	if _, err := sx.ConvertJSONKeys([]byte(`{} x`), sx.SnakeCase); !errors.As(err, &syntaxErr) {
+		t.Errorf("ConvertJSONKeys() error = %v, want *sx.SyntaxError", err)
+	}
+	if _, err := sx.ConvertJSONKeys([]byte(`{"userId":1,"user_id":2}`), sx.SnakeCase); !errors.Is(err, sx.ErrDuplicateKey) {
+		t.Errorf("ConvertJSONKeys() error = %v, want sx.ErrDuplicateKey", err)
+	}
+}
+
+func TestConvertJSONKeysContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result, err := sx.ConvertJSONKeysContext(ctx, []byte(`{"userId":1}`), sx.SnakeCase); !errors.Is(err, context.Canceled) || result != nil {
+		t.Errorf("ConvertJSONKeysContext(canceled) = %s, %v, want nil, context.Canceled", result, err)
+	}
+}
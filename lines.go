@@ -0,0 +1,119 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+)
+
+// LinesOption configures ProcessLines
+type LinesOption func(*LinesConfig)
+
+// LinesConfig holds the configuration for ProcessLines
+type LinesConfig struct {
+	// BufferSize is the initial size of the read buffer in bytes
+	BufferSize int
+	// MaxLineSize is the longest line accepted, in bytes, including its line ending
+	MaxLineSize int
+}
+
+// defaultLinesConfig returns the default configuration for ProcessLines
+func defaultLinesConfig() *LinesConfig {
+	return &LinesConfig{
+		BufferSize:  64 * 1024,
+		MaxLineSize: bufio.MaxScanTokenSize * 16,
+	}
+}
+
+// WithLineBufferSize sets the initial size of the read buffer (default 64 KiB)
+func WithLineBufferSize(size int) LinesOption {
+	return func(c *LinesConfig) {
+		c.BufferSize = size
+	}
+}
+
+// WithMaxLineSize sets the longest line accepted (default 1 MiB). Longer
+// lines make ProcessLines fail with bufio.ErrTooLong.
+func WithMaxLineSize(size int) LinesOption {
+	return func(c *LinesConfig) {
+		c.MaxLineSize = size
+	}
+}
+
+// ProcessLines calls fn for every line read from r and writes the lines it
+// returns to w. Lines are passed to fn without their line ending and written
+// back with the ending they had, so "\n" and "\r\n" files keep their format
+// and a missing final newline stays missing. Lines for which fn returns false
+// are dropped. Output is buffered and flushed before ProcessLines returns.
+//
+// Example:
+//
+//	// Scrub email addresses from a log and drop debug lines
+//	ProcessLines(os.Stdin, os.Stdout, func(line string) (string, bool) {
+//		return email.ReplaceAllString(line, "<email>"), !strings.Contains(line, "DEBUG")
+//	})
+func ProcessLines(r io.Reader, w io.Writer, fn func(line string) (string, bool), opts ...LinesOption) error {
+	return ProcessLinesContext(context.Background(), r, w, fn, opts...)
+}
+
+// ProcessLinesContext is like ProcessLines, but stops with the context's
+// error once ctx is done. It checks ctx before every line, so a read
+// blocked on r is not interrupted; the lines processed so far are flushed
+// to w.
+func ProcessLinesContext(ctx context.Context, r io.Reader, w io.Writer, fn func(line string) (string, bool), opts ...LinesOption) error {
+	config := defaultLinesConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(config.BufferSize, config.MaxLineSize)), config.MaxLineSize)
+	scanner.Split(scanLinesWithEndings)
+
+	out := bufio.NewWriter(w)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			out.Flush()
+			return err
+		}
+		content, ending := splitLineEnding(scanner.Text())
+		result, keep := fn(content)
+		if !keep {
+			continue
+		}
+		out.WriteString(result)
+		if _, err := out.WriteString(ending); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// splitLineEnding splits line into its content and its "\n" or "\r\n" ending
+func splitLineEnding(line string) (content, ending string) {
+	content, ok := strings.CutSuffix(line, "\n")
+	if !ok {
+		return line, ""
+	}
+	content = strings.TrimSuffix(content, "\r")
+	return content, line[len(content):]
+}
+
+// scanLinesWithEndings is a bufio.SplitFunc like bufio.ScanLines that keeps
+// the line ending as part of the token
+func scanLinesWithEndings(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i+1], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
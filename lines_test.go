@@ -0,0 +1,75 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestProcessLines(t *testing.T) {
+	upper := func(line string) (string, bool) { return strings.ToUpper(line), true }
+	dropEmpty := func(line string) (string, bool) { return line, line != "" }
+
+	tests := []struct {
+		name     string
+		input    string
+		fn       func(string) (string, bool)
+		options  []sx.LinesOption
+		expected string
+	}{
+		{name: "lf", input: "a\nb\n", fn: upper, expected: "A\nB\n"},
+		{name: "crlf preserved", input: "a\r\nb\r\n", fn: upper, expected: "A\r\nB\r\n"},
+		{name: "mixed endings", input: "a\r\nb\nc", fn: upper, expected: "A\r\nB\nC"},
+		{name: "no final newline", input: "a\nb", fn: upper, expected: "A\nB"},
+		{name: "lone carriage return kept", input: "a\rb\nc\r", fn: upper, expected: "A\rB\nC\r"},
+		{name: "dropped lines", input: "a\n\nb\r\n\r\n", fn: dropEmpty, expected: "a\nb\r\n"},
+		{name: "empty", input: "", fn: upper, expected: ""},
+		{name: "small buffer grows", input: strings.Repeat("x", 100) + "\n", fn: upper, options: []sx.LinesOption{sx.WithLineBufferSize(8)}, expected: strings.Repeat("X", 100) + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			if err := sx.ProcessLines(strings.NewReader(tt.input), &out, tt.fn, tt.options...); err != nil {
+				t.Fatalf("ProcessLines() error = %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("ProcessLines(%q) = %q, want %q", tt.input, out.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestProcessLinesTooLong(t *testing.T) {
+	input := strings.NewReader("short\n" + strings.Repeat("x", 100) + "\n")
+	var out strings.Builder
+	err := sx.ProcessLines(input, &out, func(line string) (string, bool) { return line, true }, sx.WithMaxLineSize(50))
+	if !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("ProcessLines() error = %v, want bufio.ErrTooLong", err)
+	}
+}
+
+func TestProcessLinesContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var out strings.Builder
+	err := sx.ProcessLinesContext(ctx, strings.NewReader("a\nstop\nb\n"), &out, func(line string) (string, bool) {
+		if line == "stop" {
+			cancel()
+		}
+		return strings.ToUpper(line), true
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ProcessLinesContext() error = %v, want context.Canceled", err)
+	}
+	if expected := "A\nSTOP\n"; out.String() != expected {
+		t.Errorf("ProcessLinesContext() wrote %q, want %q", out.String(), expected)
+	}
+}
@@ -0,0 +1,77 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// numberFormat describes how a locale writes numbers
+type numberFormat struct {
+	decimal string
+	group   string
+	// secondary is the size of groups after the first, when it differs from 3
+	// as in Indian lakh and crore grouping (12,34,567)
+	secondary int
+}
+
+// numberFormats maps language and language-region tags to their number format.
+// Region-specific entries take precedence over the bare language. Spaces used
+// for grouping are non-breaking, as in CLDR.
+var numberFormats = map[string]numberFormat{
+	"en":    {decimal: ".", group: ","},
+	"en-in": {decimal: ".", group: ",", secondary: 2},
+	"en-za": {decimal: ",", group: "\u00A0"},
+	"ja":    {decimal: ".", group: ","},
+	"ko":    {decimal: ".", group: ","},
+	"zh":    {decimal: ".", group: ","},
+	"he":    {decimal: ".", group: ","},
+	"th":    {decimal: ".", group: ","},
+	"hi":    {decimal: ".", group: ",", secondary: 2},
+	"de":    {decimal: ",", group: "."},
+	"de-ch": {decimal: ".", group: "’"},
+	"de-at": {decimal: ",", group: "\u00A0"},
+	"es":    {decimal: ",", group: "."},
+	"es-mx": {decimal: ".", group: ","},
+	"it":    {decimal: ",", group: "."},
+	"it-ch": {decimal: ".", group: "’"},
+	"nl":    {decimal: ",", group: "."},
+	"pt":    {decimal: ",", group: "\u00A0"},
+	"pt-br": {decimal: ",", group: "."},
+	"tr":    {decimal: ",", group: "."},
+	"id":    {decimal: ",", group: "."},
+	"da":    {decimal: ",", group: "."},
+	"el":    {decimal: ",", group: "."},
+	"fr":    {decimal: ",", group: "\u202F"},
+	"fr-ch": {decimal: ",", group: "\u202F"},
+	"ru":    {decimal: ",", group: "\u00A0"},
+	"uk":    {decimal: ",", group: "\u00A0"},
+	"pl":    {decimal: ",", group: "\u00A0"},
+	"cs":    {decimal: ",", group: "\u00A0"},
+	"sk":    {decimal: ",", group: "\u00A0"},
+	"sv":    {decimal: ",", group: "\u00A0"},
+	"nb":    {decimal: ",", group: "\u00A0"},
+	"no":    {decimal: ",", group: "\u00A0"},
+	"fi":    {decimal: ",", group: "\u00A0"},
+	"hu":    {decimal: ",", group: "\u00A0"},
+}
+
+// lookupNumberFormat returns the number format for a locale tag such as
+// "de", "pt-BR" or "fr_CH", falling back to the language and then to English
+func lookupNumberFormat(locale string) numberFormat {
+	tag, lang := parseLocale(locale)
+	if f, ok := numberFormats[tag]; ok {
+		return f
+	}
+	if f, ok := numberFormats[lang]; ok {
+		return f
+	}
+
+	return numberFormats["en"]
+}
+
+// parseLocale normalizes a locale tag such as "pt_BR" to lowercase,
+// hyphen-separated form and returns it together with its language subtag
+func parseLocale(locale string) (tag, lang string) {
+	tag = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	lang, _, _ = strings.Cut(tag, "-")
+	return tag, lang
+}
@@ -0,0 +1,111 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// loremWords is the vocabulary of the classic lorem ipsum filler text
+var loremWords = strings.Fields(`lorem ipsum dolor sit amet consectetur adipiscing
+elit sed do eiusmod tempor incididunt ut labore et dolore magna aliqua enim ad
+minim veniam quis nostrud exercitation ullamco laboris nisi aliquip ex ea
+commodo consequat duis aute irure in reprehenderit voluptate velit esse cillum
+fugiat nulla pariatur excepteur sint occaecat cupidatat non proident sunt culpa
+qui officia deserunt mollit anim id est laborum`)
+
+// loremOpening is the traditional start of lorem ipsum text
+var loremOpening = loremWords[:5]
+
+// LoremOption configures the lorem ipsum generators
+type LoremOption func(*LoremConfig)
+
+// LoremConfig holds the configuration for the lorem ipsum generators
+type LoremConfig struct {
+	// Seed selects the pseudo-random text; the same seed always produces the same text
+	Seed uint64
+}
+
+// defaultLoremConfig returns the default configuration for the lorem ipsum generators
+func defaultLoremConfig() *LoremConfig {
+	return &LoremConfig{}
+}
+
+// WithLoremSeed sets the seed of the generated text (default 0)
+func WithLoremSeed(seed uint64) LoremOption {
+	return func(c *LoremConfig) {
+		c.Seed = seed
+	}
+}
+
+// loremGenerator produces words and sentences of filler text
+type loremGenerator struct {
+	rand  *seededRand
+	words int
+}
+
+// newLoremGenerator returns a generator configured by opts
+func newLoremGenerator(opts []LoremOption) *loremGenerator {
+	config := defaultLoremConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &loremGenerator{rand: newSeededRand(config.Seed)}
+}
+
+// word returns the next word, starting with the traditional opening
+func (g *loremGenerator) word() string {
+	defer func() { g.words++ }()
+	if g.words < len(loremOpening) {
+		return loremOpening[g.words]
+	}
+	return loremWords[g.rand.intN(len(loremWords))]
+}
+
+// sentence returns a capitalized sentence of n words ending in a period
+func (g *loremGenerator) sentence(n int) string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = g.word()
+	}
+	return capitalizeWord(strings.Join(words, " ")) + "."
+}
+
+// sentences returns n sentences of 6 to 14 words separated by spaces
+func (g *loremGenerator) sentences(n int) string {
+	sentences := make([]string, n)
+	for i := range sentences {
+		sentences[i] = g.sentence(g.rand.between(6, 14))
+	}
+	return strings.Join(sentences, " ")
+}
+
+// Lorem returns a sentence of lorem ipsum filler text with the given number
+// of words, starting with "Lorem ipsum dolor sit amet". The text is
+// deterministic: the same arguments always produce the same output.
+func Lorem(words int, opts ...LoremOption) string {
+	if words <= 0 {
+		return ""
+	}
+	return newLoremGenerator(opts).sentence(words)
+}
+
+// LoremSentences returns n sentences of deterministic lorem ipsum filler text
+func LoremSentences(n int, opts ...LoremOption) string {
+	if n <= 0 {
+		return ""
+	}
+	return newLoremGenerator(opts).sentences(n)
+}
+
+// LoremParagraphs returns n paragraphs of 4 to 7 sentences of deterministic
+// lorem ipsum filler text, separated by blank lines
+func LoremParagraphs(n int, opts ...LoremOption) string {
+	if n <= 0 {
+		return ""
+	}
+	g := newLoremGenerator(opts)
+	paragraphs := make([]string, n)
+	for i := range paragraphs {
+		paragraphs[i] = g.sentences(g.rand.between(4, 7))
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
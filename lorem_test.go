@@ -0,0 +1,104 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestLorem(t *testing.T) {
+	tests := []struct {
+		name     string
+		words    int
+		expected string
+	}{
+		{name: "zero", words: 0, expected: ""},
+		{name: "negative", words: -1, expected: ""},
+		{name: "one word", words: 1, expected: "Lorem."},
+		{name: "opening", words: 5, expected: "Lorem ipsum dolor sit amet."},
+		{name: "stable output", words: 12, expected: "Lorem ipsum dolor sit amet ullamco voluptate enim exercitation eiusmod consequat minim."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Lorem(tt.words)
+			if result != tt.expected {
+				t.Errorf("Lorem(%d) = %q, want %q", tt.words, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoremSeed(t *testing.T) {
+	a := sx.Lorem(50, sx.WithLoremSeed(7))
+	if b := sx.Lorem(50, sx.WithLoremSeed(7)); a != b {
+		t.Errorf("Lorem with the same seed differs: %q and %q", a, b)
+	}
+	if b := sx.Lorem(50, sx.WithLoremSeed(8)); a == b {
+		t.Errorf("Lorem with different seeds produced the same text %q", a)
+	}
+	if n := len(strings.Fields(a)); n != 50 {
+		t.Errorf("Lorem(50) returned %d words", n)
+	}
+}
+
+func TestLoremSentences(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+	}{
+		{name: "zero", n: 0},
+		{name: "one", n: 1},
+		{name: "several", n: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.LoremSentences(tt.n)
+			if count := strings.Count(result, "."); count != tt.n {
+				t.Errorf("LoremSentences(%d) has %d sentences: %q", tt.n, count, result)
+			}
+			if tt.n > 0 && !strings.HasPrefix(result, "Lorem ipsum dolor sit amet") {
+				t.Errorf("LoremSentences(%d) = %q, want the traditional opening", tt.n, result)
+			}
+			if result != sx.LoremSentences(tt.n) {
+				t.Errorf("LoremSentences(%d) is not deterministic", tt.n)
+			}
+		})
+	}
+}
+
+func TestLoremParagraphs(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+	}{
+		{name: "zero", n: 0},
+		{name: "one", n: 1},
+		{name: "several", n: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.LoremParagraphs(tt.n)
+			if tt.n == 0 {
+				if result != "" {
+					t.Errorf("LoremParagraphs(0) = %q, want empty", result)
+				}
+				return
+			}
+			paragraphs := strings.Split(result, "\n\n")
+			if len(paragraphs) != tt.n {
+				t.Fatalf("LoremParagraphs(%d) has %d paragraphs", tt.n, len(paragraphs))
+			}
+			for _, p := range paragraphs {
+				if count := strings.Count(p, "."); count < 4 || count > 7 {
+					t.Errorf("paragraph has %d sentences, want 4 to 7: %q", count, p)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package sx
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// WordsInfo records how a string was split into words: the words in their
+// original spelling, acronym casing included, and the exact text between
+// them. It lets a string that was converted, say to normalize a key, be
+// restored byte-for-byte later, see WithLossless.
+type WordsInfo struct {
+	// Original is the string that was split
+	Original string
+	// Words are the words of Original in their original spelling
+	Words []string
+	// Separators holds the text around the words: Separators[i] precedes
+	// Words[i], and the last element follows the last word
+	Separators []string
+}
+
+// WithLossless enables lossless mode: the split of a string input is
+// recorded into info, so the original string can be rebuilt from the
+// converted one with WordsInfo.Restore. Input that is already in the target
+// case is split as well. A Config with this option must not be shared
+// between goroutines.
+//
+// Example:
+//
+//	var info WordsInfo
+//	key := SnakeCase("HTTPServer--ID", WithLossless(&info)) // http_server__id
+//	info.Restore(key)                                       // "HTTPServer--ID", true
+func WithLossless(info *WordsInfo) Option {
+	return func(c *Config) {
+		c.lossless = info
+	}
+}
+
+// newWordsInfo records the split of original into words. clean is original
+// after the UTF-8 policy was applied, and words are subslices of it.
+func newWordsInfo(original, clean string, words []string) WordsInfo {
+	info := WordsInfo{
+		Original:   original,
+		Words:      slices.Clone(words),
+		Separators: make([]string, 0, len(words)+1),
+	}
+	pos := 0
+	for i, word := range words {
+		start := pos + max(strings.Index(clean[pos:], word), 0)
+		if word == "" && i > 0 {
+			// An empty word follows the separator that ended the previous word
+			_, size := utf8.DecodeRuneInString(clean[pos:])
+			start = pos + size
+		}
+		info.Separators = append(info.Separators, clean[pos:start])
+		pos = start + len(word)
+	}
+	info.Separators = append(info.Separators, clean[pos:])
+	return info
+}
+
+// Restore rebuilds a string in the original format from converted, which
+// must have the same non-empty words as the original in any case style.
+// Words that still match their original case-insensitively get their
+// original spelling back, so an unmodified conversion restores the original
+// exactly. Edited words take the casing pattern (lower, upper or
+// capitalized) of the word they replace. ok is false when converted has a
+// different number of words. opts configure how converted is split.
+//
+// Example:
+//
+//	var info WordsInfo
+//	SnakeCase("userID", WithLossless(&info)) // user_id
+//	info.Restore("account_id")               // "accountID", true
+func (w WordsInfo) Restore(converted string, opts ...Option) (string, bool) {
+	// Unmodified words give back the original exactly, even where the UTF-8
+	// policy changed it while splitting. They are matched against the text
+	// rather than split again, as converting can move word boundaries:
+	// "address_line_1" becomes "addressLine1", which splits into two words.
+	config := configFor(opts)
+	if w.matches(converted, config) {
+		return w.Original, true
+	}
+
+	var words []string
+	for _, word := range config.instance().split(converted) {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	var result strings.Builder
+	next := 0
+	for i, original := range w.Words {
+		result.WriteString(w.Separators[i])
+		if original == "" {
+			continue
+		}
+		if next == len(words) {
+			return "", false
+		}
+		result.WriteString(restoreWord(original, words[next]))
+		next++
+	}
+	if next != len(words) {
+		return "", false
+	}
+	result.WriteString(w.Separators[len(w.Words)])
+	return result.String(), true
+}
+
+// matches reports whether converted consists of the non-empty original words
+// in order, up to case, with only separators of config around them
+func (w WordsInfo) matches(converted string, config *Config) bool {
+	pos := 0
+	for _, original := range w.Words {
+		if original == "" {
+			continue
+		}
+		for {
+			if n := prefixFoldLen(converted[pos:], original); n > 0 {
+				pos += n
+				break
+			}
+			r, size := utf8.DecodeRuneInString(converted[pos:])
+			if size == 0 || !config.isSeparator(r) {
+				return false
+			}
+			pos += size
+		}
+	}
+	for _, r := range converted[pos:] {
+		if !config.isSeparator(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// prefixFoldLen returns the byte length of the prefix of s that matches word
+// case-insensitively, rune for rune, or 0 if there is none
+func prefixFoldLen(s, word string) int {
+	n := 0
+	for range utf8.RuneCountInString(word) {
+		if n == len(s) {
+			return 0
+		}
+		_, size := utf8.DecodeRuneInString(s[n:])
+		n += size
+	}
+	if !equalFold(s[:n], word) {
+		return 0
+	}
+	return n
+}
+
+// restoreWord returns word in the spelling of original when they match
+// case-insensitively, and in its casing pattern otherwise
+func restoreWord(original, word string) string {
+	switch {
+	case equalFold(original, word):
+		return original
+	case isUpperWord(original):
+		return toUpperString(word)
+	case keepsLower(original):
+		return toLowerString(word)
+	case original == capitalizeWord(toLowerString(original)):
+		return capitalizeWord(toLowerString(word))
+	default:
+		return word
+	}
+}
@@ -0,0 +1,96 @@
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWithLossless(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		options    []sx.Option
+		converted  string
+		words      []string
+		separators []string
+	}{
+		{name: "camel", input: "userID", converted: "user_id", words: []string{"user", "ID"}, separators: []string{"", "", ""}},
+		{name: "separators kept", input: "_HTTPServer--ID ", converted: "_http_server__id", words: []string{"", "HTTP", "Server", "", "ID"}, separators: []string{"", "_", "", "-", "-", " "}},
+		{name: "already snake", input: "user_id", converted: "user_id", words: []string{"user", "id"}, separators: []string{"", "_", ""}},
+		{name: "protected", input: "get{userId}ById", options: []sx.Option{sx.WithProtectedDelimiters(sx.Pair{"{", "}"})}, converted: "get_{userId}_by_id", words: []string{"get", "{userId}", "By", "Id"}, separators: []string{"", "", "", "", ""}},
+		{name: "empty", input: "", converted: "", words: nil, separators: []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info sx.WordsInfo
+			converted := sx.SnakeCase(tt.input, append(tt.options, sx.WithLossless(&info))...)
+			if converted != tt.converted {
+				t.Errorf("SnakeCase(%q) = %q, want %q", tt.input, converted, tt.converted)
+			}
+			if info.Original != tt.input || !reflect.DeepEqual(info.Words, tt.words) || !reflect.DeepEqual(info.Separators, tt.separators) {
+				t.Errorf("info = %+q, want words %q and separators %q", info, tt.words, tt.separators)
+			}
+			for _, c := range []string{converted, sx.PascalCase(converted, tt.options...), sx.DelimitedCase(converted, "-", tt.options...)} {
+				if restored, ok := info.Restore(c, tt.options...); !ok || restored != tt.input {
+					t.Errorf("Restore(%q) = %q, %v, want %q", c, restored, ok, tt.input)
+				}
+			}
+		})
+	}
+}
+
+func TestWordsInfoRestore(t *testing.T) {
+	tests := []struct {
+		name      string
+		original  string
+		converted string
+		expected  string
+		ok        bool
+	}{
+		{name: "unchanged", original: "XMLHttpRequest", converted: "xml_http_request", expected: "XMLHttpRequest", ok: true},
+		{name: "edited lowercase", original: "user_ID", converted: "accountId", expected: "account_ID", ok: true},
+		{name: "edited upper and capitalized", original: "Get-URL", converted: "set-uri", expected: "Set-URI", ok: true},
+		{name: "too few words", original: "a_b_c", converted: "a_b", ok: false},
+		{name: "too many words", original: "a_b", converted: "a_b_c", ok: false},
+		{name: "invalid utf-8 restored exactly", original: "foo\xff_bar", converted: "FOO\uFFFD-BAR", expected: "foo\xff_bar", ok: true},
+		{name: "invalid utf-8 edited", original: "foo\xff_bar", converted: "BazBar", expected: "baz_bar", ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info sx.WordsInfo
+			sx.SplitByCase(tt.original, sx.WithLossless(&info))
+			restored, ok := info.Restore(tt.converted)
+			if ok != tt.ok || restored != tt.expected {
+				t.Errorf("Restore(%q) = %q, %v, want %q, %v", tt.converted, restored, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestWordsInfoRoundTrip(t *testing.T) {
+	converters := map[string]func(string, ...sx.Option) string{
+		"camel":  sx.CamelCase[string],
+		"pascal": sx.PascalCase[string],
+		"snake":  sx.SnakeCase[string],
+		"kebab":  func(s string, opts ...sx.Option) string { return sx.DelimitedCase(s, "-", opts...) },
+		"train":  sx.TrainCase[string],
+		"flat":   sx.FlatCase[string],
+	}
+	inputs := []string{"address_line_1", "item_2", "user_2fa", "v_1_api", "HTTPServer--ID", "XMLHttpRequest", "_private_key "}
+
+	for name, convert := range converters {
+		for _, input := range inputs {
+			t.Run(name+"/"+input, func(t *testing.T) {
+				var info sx.WordsInfo
+				converted := convert(input, sx.WithLossless(&info))
+				if restored, ok := info.Restore(converted); !ok || restored != input {
+					t.Errorf("Restore(%q) = %q, %v, want %q", converted, restored, ok, input)
+				}
+			})
+		}
+	}
+}
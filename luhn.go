@@ -0,0 +1,68 @@
+//go:build !sx_ascii
+
+package sx
+
+import "fmt"
+
+// luhnDigits extracts the digits of s, ignoring spaces and dashes. It returns
+// a *SyntaxError for any other character.
+func luhnDigits(s string) ([]byte, error) {
+	digits := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c-'0')
+		case c == ' ' || c == '-':
+		default:
+			return nil, &SyntaxError{Msg: fmt.Sprintf("invalid luhn character %q", c), Offset: i}
+		}
+	}
+	return digits, nil
+}
+
+// luhnSum returns the Luhn checksum of digits, doubling every second digit
+// from the right starting with the rightmost when double is true
+func luhnSum(digits []byte, double bool) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i])
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum
+}
+
+// LuhnValid reports whether s is a valid Luhn (mod 10) number, such as a
+// payment card number or IMEI. Spaces and dashes are ignored; any other
+// non-digit character, or fewer than two digits, makes s invalid.
+func LuhnValid(s string) bool {
+	digits, err := luhnDigits(s)
+	if err != nil || len(digits) < 2 {
+		return false
+	}
+	return luhnSum(digits, false)%10 == 0
+}
+
+// LuhnCheckDigit returns the digit that, appended to s, makes it a valid Luhn
+// number. Spaces and dashes are ignored; other non-digit characters or an
+// input without digits result in a *SyntaxError.
+//
+// Example:
+//
+//	LuhnCheckDigit("7992739871") // 3, nil
+func LuhnCheckDigit(s string) (int, error) {
+	digits, err := luhnDigits(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(digits) == 0 {
+		return 0, &SyntaxError{Msg: "no digits", Offset: len(s)}
+	}
+	return (10 - luhnSum(digits, true)%10) % 10, nil
+}
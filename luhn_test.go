@@ -0,0 +1,70 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestLuhnValid(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "79927398713", expected: true},
+		{input: "79927398710", expected: false},
+		{input: "4111 1111 1111 1111", expected: true},
+		{input: "4111-1111-1111-1112", expected: false},
+		{input: "378282246310005", expected: true},
+		{input: "00", expected: true},
+		{input: "0", expected: false},
+		{input: "4111x1111", expected: false},
+		{input: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := sx.LuhnValid(tt.input)
+			if result != tt.expected {
+				t.Errorf("LuhnValid(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  int
+		expectErr bool
+	}{
+		{input: "7992739871", expected: 3},
+		{input: "4111 1111 1111 111", expected: 1},
+		{input: "37828224631000", expected: 5},
+		{input: "0", expected: 0},
+		{input: "12a", expectErr: true},
+		{input: " - ", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := sx.LuhnCheckDigit(tt.input)
+			if tt.expectErr {
+				var syntaxErr *sx.SyntaxError
+				if !errors.As(err, &syntaxErr) {
+					t.Errorf("LuhnCheckDigit(%q) error = %v, want *SyntaxError", tt.input, err)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("LuhnCheckDigit(%q) = %d, %v, want %d", tt.input, result, err, tt.expected)
+			}
+			if !sx.LuhnValid(tt.input + string(rune('0'+result))) {
+				t.Errorf("LuhnValid(%q + check digit) = false", tt.input)
+			}
+		})
+	}
+}
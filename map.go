@@ -0,0 +1,110 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"iter"
+	"slices"
+)
+
+// identKey normalizes an identifier so that spellings differing only in case
+// and separators ("UserID", "user_id", "user-id") produce the same key
+func identKey(s string) string {
+	return emptyConfig.delimitedCase(s, "")
+}
+
+// mapEntry holds a value together with the key it was first stored under
+type mapEntry[V any] struct {
+	key   string
+	value V
+}
+
+// Map is a map keyed by identifiers that ignores case and separators,
+// so m.Get("user_id") finds a value stored under "UserID".
+// Original keys are preserved and iteration follows insertion order.
+// The zero value is not usable; create maps with NewMap.
+type Map[V any] struct {
+	entries map[string]*mapEntry[V]
+	order   []string
+}
+
+// NewMap returns an empty Map
+func NewMap[V any]() *Map[V] {
+	return &Map[V]{entries: make(map[string]*mapEntry[V])}
+}
+
+// Set stores value under key. If an equivalent key already exists its value is
+// replaced and the original spelling of the key is kept.
+func (m *Map[V]) Set(key string, value V) {
+	k := identKey(key)
+	if e, ok := m.entries[k]; ok {
+		e.value = value
+		return
+	}
+
+	m.entries[k] = &mapEntry[V]{key: key, value: value}
+	m.order = append(m.order, k)
+}
+
+// Get returns the value stored under any key equivalent to key
+func (m *Map[V]) Get(key string) (V, bool) {
+	if e, ok := m.entries[identKey(key)]; ok {
+		return e.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has reports whether an equivalent key is present
+func (m *Map[V]) Has(key string) bool {
+	_, ok := m.entries[identKey(key)]
+	return ok
+}
+
+// Key returns the original spelling stored for an equivalent key
+func (m *Map[V]) Key(key string) (string, bool) {
+	if e, ok := m.entries[identKey(key)]; ok {
+		return e.key, true
+	}
+	return "", false
+}
+
+// Delete removes the entry stored under any key equivalent to key
+func (m *Map[V]) Delete(key string) {
+	k := identKey(key)
+	if _, ok := m.entries[k]; !ok {
+		return
+	}
+
+	delete(m.entries, k)
+	if i := slices.Index(m.order, k); i >= 0 {
+		m.order = slices.Delete(m.order, i, i+1)
+	}
+}
+
+// Len returns the number of entries
+func (m *Map[V]) Len() int {
+	return len(m.order)
+}
+
+// Keys returns the original keys in insertion order
+func (m *Map[V]) Keys() []string {
+	keys := make([]string, len(m.order))
+	for i, k := range m.order {
+		keys[i] = m.entries[k].key
+	}
+	return keys
+}
+
+// All iterates over original keys and values in insertion order
+func (m *Map[V]) All() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		for _, k := range m.order {
+			e := m.entries[k]
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestMap(t *testing.T) {
+	m := sx.NewMap[int]()
+	m.Set("UserID", 1)
+	m.Set("createdAt", 2)
+	m.Set("user_id", 3)
+
+	tests := []struct {
+		name     string
+		key      string
+		expected int
+		found    bool
+	}{
+		{name: "original key", key: "UserID", expected: 3, found: true},
+		{name: "snake_case", key: "user_id", expected: 3, found: true},
+		{name: "kebab-case", key: "user-id", expected: 3, found: true},
+		{name: "camelCase", key: "userId", expected: 3, found: true},
+		{name: "screaming", key: "CREATED_AT", expected: 2, found: true},
+		{name: "missing", key: "updated_at", expected: 0, found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := m.Get(tt.key)
+			if got != tt.expected || ok != tt.found {
+				t.Errorf("Get(%q) = %d, %v, want %d, %v", tt.key, got, ok, tt.expected, tt.found)
+			}
+		})
+	}
+
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"UserID", "createdAt"}) {
+		t.Errorf("Keys() = %v, want [UserID createdAt]", got)
+	}
+
+	if key, _ := m.Key("user-id"); key != "UserID" {
+		t.Errorf("Key(%q) = %q, want %q", "user-id", key, "UserID")
+	}
+
+	m.Delete("user.id")
+	if m.Has("UserID") || m.Len() != 1 {
+		t.Errorf("Delete did not remove entry, Len() = %d", m.Len())
+	}
+
+	var keys []string
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		if v != 2 {
+			t.Errorf("All() yielded %q = %d, want 2", k, v)
+		}
+	}
+	if !reflect.DeepEqual(keys, []string{"createdAt"}) {
+		t.Errorf("All() keys = %v, want [createdAt]", keys)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"math/rand/v2"
+	"strings"
+	"unicode"
+)
+
+// AlternatingCase alternates the case of letters, sPoNgEbOb style. The
+// alternation starts lowercase when seed is even and uppercase when it is
+// odd, so a fixed seed always produces the same output. Non-letters are kept
+// and do not advance the alternation.
+func AlternatingCase(s string, seed int64) string {
+	upper := seed%2 != 0
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) {
+			return r
+		}
+		upper = !upper
+		if upper {
+			return unicode.ToLower(r)
+		}
+		return unicode.ToUpper(r)
+	}, s)
+}
+
+// RandomCase randomly upper- or lowercases each letter of s. It is intended
+// for generating case-insensitivity test fixtures, not for security.
+func RandomCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		if rand.IntN(2) == 0 {
+			return unicode.ToUpper(r)
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,43 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestAlternatingCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		seed     int64
+		expected string
+	}{
+		{name: "even seed", input: "spongebob", seed: 0, expected: "sPoNgEbOb"},
+		{name: "odd seed", input: "spongebob", seed: 1, expected: "SpOnGeBoB"},
+		{name: "negative odd seed", input: "ab", seed: -3, expected: "Ab"},
+		{name: "non-letters skipped", input: "is it ok?", seed: 0, expected: "iS iT oK?"},
+		{name: "unicode", input: "\u00c9T\u00c9", seed: 0, expected: "\u00e9T\u00e9"},
+		{name: "empty", input: "", seed: 0, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.AlternatingCase(tt.input, tt.seed)
+			if result != tt.expected {
+				t.Errorf("AlternatingCase(%q, %d) = %q, want %q", tt.input, tt.seed, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRandomCase(t *testing.T) {
+	input := "The Quick Brown Fox 123"
+	result := sx.RandomCase(input)
+	if !strings.EqualFold(result, input) {
+		t.Errorf("RandomCase(%q) = %q, not equal under case folding", input, result)
+	}
+}
@@ -0,0 +1,129 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// morseCodes maps characters to their International Morse Code
+var morseCodes = map[rune]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".", 'F': "..-.",
+	'G': "--.", 'H': "....", 'I': "..", 'J': ".---", 'K': "-.-", 'L': ".-..",
+	'M': "--", 'N': "-.", 'O': "---", 'P': ".--.", 'Q': "--.-", 'R': ".-.",
+	'S': "...", 'T': "-", 'U': "..-", 'V': "...-", 'W': ".--", 'X': "-..-",
+	'Y': "-.--", 'Z': "--..",
+	'0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.",
+	'.': ".-.-.-", ',': "--..--", '?': "..--..", '\'': ".----.", '!': "-.-.--",
+	'/': "-..-.", '(': "-.--.", ')': "-.--.-", '&': ".-...", ':': "---...",
+	';': "-.-.-.", '=': "-...-", '+': ".-.-.", '-': "-....-", '_': "..--.-",
+	'"': ".-..-.", '$': "...-..-", '@': ".--.-.",
+}
+
+// morseLetters is the reverse of morseCodes
+var morseLetters = sync.OnceValue(func() map[string]rune {
+	letters := make(map[string]rune, len(morseCodes))
+	for r, code := range morseCodes {
+		letters[code] = r
+	}
+	return letters
+})
+
+// MorseOption configures ToMorse and FromMorse
+type MorseOption func(*MorseConfig)
+
+// MorseConfig holds the separators used in Morse code text
+type MorseConfig struct {
+	// LetterSeparator separates the codes of letters within a word
+	LetterSeparator string
+	// WordSeparator separates words
+	WordSeparator string
+}
+
+// defaultMorseConfig returns the default configuration: letters separated by
+// a space and words by " / "
+func defaultMorseConfig() *MorseConfig {
+	return &MorseConfig{
+		LetterSeparator: " ",
+		WordSeparator:   " / ",
+	}
+}
+
+// WithLetterSeparator sets the separator between letters (default " ")
+func WithLetterSeparator(sep string) MorseOption {
+	return func(c *MorseConfig) {
+		c.LetterSeparator = sep
+	}
+}
+
+// WithWordSeparator sets the separator between words (default " / ")
+func WithWordSeparator(sep string) MorseOption {
+	return func(c *MorseConfig) {
+		c.WordSeparator = sep
+	}
+}
+
+// ToMorse encodes s in International Morse Code. Letters are case-insensitive;
+// characters without a Morse code are dropped.
+//
+// Example:
+//
+//	ToMorse("SOS help") // "... --- ... / .... . .-.. .--."
+func ToMorse(s string, opts ...MorseOption) string {
+	config := defaultMorseConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	var words []string
+	for word := range strings.FieldsSeq(s) {
+		var codes []string
+		for _, r := range word {
+			if code, ok := morseCodes[unicode.ToUpper(r)]; ok {
+				codes = append(codes, code)
+			}
+		}
+		if len(codes) > 0 {
+			words = append(words, strings.Join(codes, config.LetterSeparator))
+		}
+	}
+	return strings.Join(words, config.WordSeparator)
+}
+
+// FromMorse decodes Morse code written with the configured separators into
+// uppercase text. Unknown codes result in a *SyntaxError.
+func FromMorse(s string, opts ...MorseOption) (string, error) {
+	config := defaultMorseConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	letters := morseLetters()
+	var b strings.Builder
+	offset := 0
+	for i, word := range strings.Split(s, config.WordSeparator) {
+		if i > 0 {
+			b.WriteByte(' ')
+			offset += len(config.WordSeparator)
+		}
+		for j, code := range strings.Split(word, config.LetterSeparator) {
+			if j > 0 {
+				offset += len(config.LetterSeparator)
+			}
+			trimmed := strings.TrimSpace(code)
+			if trimmed != "" {
+				r, ok := letters[trimmed]
+				if !ok {
+					return "", &SyntaxError{Msg: fmt.Sprintf("unknown morse code %q", trimmed), Offset: offset}
+				}
+				b.WriteRune(r)
+			}
+			offset += len(code)
+		}
+	}
+	return strings.TrimSpace(b.String()), nil
+}
@@ -0,0 +1,89 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestToMorse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.MorseOption
+		expected string
+	}{
+		{name: "sos", input: "SOS", expected: "... --- ..."},
+		{name: "words", input: "sos help", expected: "... --- ... / .... . .-.. .--."},
+		{name: "digits and punctuation", input: "R2-D2?", expected: ".-. ..--- -....- -.. ..--- ..--.."},
+		{name: "unknown dropped", input: "a\u00e9b", expected: ".- -..."},
+		{name: "extra spaces", input: "  a   b  ", expected: ".- / -..."},
+		{
+			name:     "custom separators",
+			input:    "hi there",
+			options:  []sx.MorseOption{sx.WithLetterSeparator("|"), sx.WithWordSeparator("  ")},
+			expected: "....|..  -|....|.|.-.|.",
+		},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.ToMorse(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("ToMorse(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromMorse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		options   []sx.MorseOption
+		expected  string
+		expectErr bool
+	}{
+		{name: "sos", input: "... --- ...", expected: "SOS"},
+		{name: "words", input: "... --- ... / .... . .-.. .--.", expected: "SOS HELP"},
+		{name: "padding", input: "  .-  -... ", expected: "AB"},
+		{
+			name:     "custom separators",
+			input:    "....|..  -|....|.|.-.|.",
+			options:  []sx.MorseOption{sx.WithLetterSeparator("|"), sx.WithWordSeparator("  ")},
+			expected: "HI THERE",
+		},
+		{name: "unknown code", input: ".- ........", expectErr: true},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.FromMorse(tt.input, tt.options...)
+			if tt.expectErr {
+				var syntaxErr *sx.SyntaxError
+				if !errors.As(err, &syntaxErr) {
+					t.Errorf("FromMorse(%q) error = %v, want *SyntaxError", tt.input, err)
+				} else if syntaxErr.Offset != 3 {
+					t.Errorf("FromMorse(%q) error offset = %d, want 3", tt.input, syntaxErr.Offset)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("FromMorse(%q) = %q, %v, want %q", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMorse_RoundTrip(t *testing.T) {
+	input := "THE QUICK BROWN FOX JUMPS OVER THE LAZY DOG 0123456789"
+	result, err := sx.FromMorse(sx.ToMorse(input))
+	if err != nil || result != input {
+		t.Errorf("FromMorse(ToMorse(%q)) = %q, %v", input, result, err)
+	}
+}
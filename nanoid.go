@@ -0,0 +1,70 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"crypto/rand"
+	"math/bits"
+)
+
+// URLAlphabet is the default NanoID alphabet: the 64 URL-safe characters
+// A-Z, a-z, 0-9, '_' and '-'
+const URLAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+// NanoID returns a random ID of n characters drawn uniformly from alphabet
+// using crypto/rand. Sampling uses a bit mask with rejection, so every
+// character is equally likely whatever the alphabet size. An empty alphabet
+// uses URLAlphabet. ErrInvalidAlphabet is returned unless the alphabet has
+// between 2 and 256 distinct characters.
+//
+// Example:
+//
+//	id, _ := NanoID(21, "")                  // "V1StGXR8_Z5jdHi6B-myT"
+//	code, _ := NanoID(6, "0123456789ABCDEF") // "3FA91C"
+func NanoID(n int, alphabet string) (string, error) {
+	chars, err := alphabetRunes(alphabet)
+	if err != nil {
+		return "", err
+	}
+	if n <= 0 {
+		return "", nil
+	}
+
+	// Smallest all-ones mask covering every index
+	mask := byte(1<<bits.Len(uint(len(chars)-1)) - 1)
+
+	id := make([]rune, 0, n)
+	buf := make([]byte, n+n/2)
+	for {
+		rand.Read(buf)
+		for _, b := range buf {
+			if idx := int(b & mask); idx < len(chars) {
+				id = append(id, chars[idx])
+				if len(id) == n {
+					return string(id), nil
+				}
+			}
+		}
+	}
+}
+
+// alphabetRunes returns the characters of a generator alphabet, defaulting to
+// URLAlphabet when it is empty. ErrInvalidAlphabet is returned unless the
+// alphabet has between 2 and 256 distinct characters.
+func alphabetRunes(alphabet string) ([]rune, error) {
+	if alphabet == "" {
+		alphabet = URLAlphabet
+	}
+	chars := []rune(alphabet)
+	if len(chars) < 2 || len(chars) > 256 {
+		return nil, ErrInvalidAlphabet
+	}
+	seen := make(map[rune]bool, len(chars))
+	for _, c := range chars {
+		if seen[c] {
+			return nil, ErrInvalidAlphabet
+		}
+		seen[c] = true
+	}
+	return chars, nil
+}
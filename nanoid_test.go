@@ -0,0 +1,68 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gomantics/sx"
+)
+
+func TestNanoID(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		alphabet string
+	}{
+		{name: "default", n: 21, alphabet: ""},
+		{name: "hex", n: 8, alphabet: "0123456789abcdef"},
+		{name: "non power of two", n: 50, alphabet: "abcde"},
+		{name: "unicode", n: 10, alphabet: "αβγδ"},
+		{name: "zero length", n: 0, alphabet: "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := sx.NanoID(tt.n, tt.alphabet)
+			if err != nil {
+				t.Fatalf("NanoID(%d, %q) error = %v", tt.n, tt.alphabet, err)
+			}
+			if utf8.RuneCountInString(id) != tt.n {
+				t.Errorf("NanoID(%d, %q) = %q, wrong length", tt.n, tt.alphabet, id)
+			}
+			alphabet := tt.alphabet
+			if alphabet == "" {
+				alphabet = sx.URLAlphabet
+			}
+			for _, r := range id {
+				if !strings.ContainsRune(alphabet, r) {
+					t.Errorf("NanoID(%d, %q) = %q, contains %q outside alphabet", tt.n, tt.alphabet, id, r)
+				}
+			}
+		})
+	}
+}
+
+func TestNanoID_Uniform(t *testing.T) {
+	id, err := sx.NanoID(30000, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range "abc" {
+		// Expect about 10000 of each; a biased sampler would be far off
+		if count := strings.Count(id, string(c)); count < 9000 || count > 11000 {
+			t.Errorf("NanoID produced %d of %q out of 30000, want about 10000", count, c)
+		}
+	}
+}
+
+func TestNanoID_InvalidAlphabet(t *testing.T) {
+	for _, alphabet := range []string{"a", "abca", strings.Repeat("x", 300)} {
+		if _, err := sx.NanoID(5, alphabet); !errors.Is(err, sx.ErrInvalidAlphabet) {
+			t.Errorf("NanoID(5, %q) error = %v, want ErrInvalidAlphabet", alphabet, err)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// PadNumber formats n with leading zeros to at least width characters,
+// keeping the sign in front: PadNumber(-5, 4) is "-005"
+func PadNumber(n, width int) string {
+	digits := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if pad := width - len(sign) - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	return sign + digits
+}
+
+// AlignDecimal pads numeric strings with spaces so that their decimal points
+// line up when printed one per line, as in a report column. Values without a
+// decimal point align as if it followed their last digit. All results have
+// the same display width. Surrounding whitespace is trimmed first.
+//
+// Example:
+//
+//	AlignDecimal([]string{"1.5", "10", "-3.25"})
+//	// []string{" 1.5 ", "10   ", "-3.25"}
+func AlignDecimal(values []string) []string {
+	ints := make([]string, len(values))
+	fracs := make([]string, len(values))
+	var intWidth, fracWidth int
+	for i, v := range values {
+		v = strings.TrimSpace(v)
+		if dot := strings.LastIndexByte(v, '.'); dot >= 0 {
+			ints[i], fracs[i] = v[:dot], v[dot:]
+		} else {
+			ints[i] = v
+		}
+		intWidth = max(intWidth, DisplayWidth(ints[i]))
+		fracWidth = max(fracWidth, DisplayWidth(fracs[i]))
+	}
+
+	aligned := make([]string, len(values))
+	for i := range values {
+		aligned[i] = padWidth(ints[i], intWidth, AlignRight) + padWidth(fracs[i], fracWidth, AlignLeft)
+	}
+	return aligned
+}
+
+// GroupRight inserts sep between groups of size runes, counting from the
+// right as is usual for digit grouping. A size <= 0 returns s unchanged.
+//
+// Example:
+//
+//	GroupRight("1234567890", 3, " ") // "1 234 567 890"
+func GroupRight(s string, size int, sep string) string {
+	if size <= 0 {
+		return s
+	}
+	runes := []rune(s)
+	return groupRunes(runes, size, sep, len(runes)%size)
+}
+
+// GroupLeft inserts sep between groups of size runes, counting from the left
+// as is usual for card numbers and IBANs. A size <= 0 returns s unchanged.
+//
+// Example:
+//
+//	GroupLeft("GB82WEST12345698765432", 4, " ") // "GB82 WEST 1234 5698 7654 32"
+func GroupLeft(s string, size int, sep string) string {
+	if size <= 0 {
+		return s
+	}
+	return groupRunes([]rune(s), size, sep, 0)
+}
+
+// groupRunes joins runes in groups of size, with the first group ending after
+// first runes (a full group when first is 0)
+func groupRunes(runes []rune, size int, sep string, first int) string {
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && (i-first)%size == 0 {
+			b.WriteString(sep)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FormatNumber formats n with precision digits after the decimal point using
+// the decimal and grouping separators of locale, such as "en", "de" or
+// "fr-CH". A negative precision uses the fewest digits that represent n
+// exactly. Unknown locales fall back to the language and then to English.
+//
+// Example:
+//
+//	FormatNumber(1234567.891, "en", 2)    // "1,234,567.89"
+//	FormatNumber(1234567.891, "de", 2)    // "1.234.567,89"
+//	FormatNumber(1234567.891, "en-IN", 0) // "12,34,568"
+func FormatNumber(n float64, locale string, precision int) string {
+	switch {
+	case math.IsNaN(n):
+		return "NaN"
+	case math.IsInf(n, 1):
+		return "∞"
+	case math.IsInf(n, -1):
+		return "-∞"
+	}
+
+	format := lookupNumberFormat(locale)
+	digits := strconv.FormatFloat(math.Abs(n), 'f', max(precision, -1), 64)
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+
+	// Group the last three digits, then every format.secondary (or three) digits
+	grouped := intPart
+	if len(intPart) > 3 {
+		secondary := cmp.Or(format.secondary, 3)
+		head := intPart[:len(intPart)-3]
+		grouped = GroupRight(head, secondary, format.group) + format.group + intPart[len(intPart)-3:]
+	}
+
+	var b strings.Builder
+	if n < 0 && strings.Trim(digits, "0.") != "" {
+		b.WriteByte('-')
+	}
+	b.WriteString(grouped)
+	if fracPart != "" {
+		b.WriteString(format.decimal)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
@@ -0,0 +1,168 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestPadNumber(t *testing.T) {
+	tests := []struct {
+		n        int
+		width    int
+		expected string
+	}{
+		{n: 5, width: 3, expected: "005"},
+		{n: -5, width: 4, expected: "-005"},
+		{n: 12345, width: 3, expected: "12345"},
+		{n: 0, width: 2, expected: "00"},
+		{n: 7, width: 0, expected: "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := sx.PadNumber(tt.n, tt.width)
+			if result != tt.expected {
+				t.Errorf("PadNumber(%d, %d) = %q, want %q", tt.n, tt.width, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAlignDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "mixed",
+			input:    []string{"1.5", "10", "-3.25"},
+			expected: []string{" 1.5 ", "10   ", "-3.25"},
+		},
+		{
+			name:     "integers",
+			input:    []string{"1", "100", " 42 "},
+			expected: []string{"  1", "100", " 42"},
+		},
+		{
+			name:     "currency and wide digits",
+			input:    []string{"$1,234.5", "１２.75"},
+			expected: []string{"$1,234.5 ", "  １２.75"},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.AlignDecimal(tt.input)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("AlignDecimal(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGroupRight(t *testing.T) {
+	tests := []struct {
+		input    string
+		size     int
+		sep      string
+		expected string
+	}{
+		{input: "1234567890", size: 3, sep: " ", expected: "1 234 567 890"},
+		{input: "123456", size: 3, sep: ",", expected: "123,456"},
+		{input: "12", size: 3, sep: ",", expected: "12"},
+		{input: "1234567812345678", size: 4, sep: " ", expected: "1234 5678 1234 5678"},
+		{input: "12345", size: 2, sep: " ", expected: "1 23 45"},
+		{input: "１２３４", size: 3, sep: ",", expected: "１,２３４"},
+		{input: "123", size: 0, sep: ",", expected: "123"},
+		{input: "", size: 3, sep: ",", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := sx.GroupRight(tt.input, tt.size, tt.sep)
+			if result != tt.expected {
+				t.Errorf("GroupRight(%q, %d, %q) = %q, want %q", tt.input, tt.size, tt.sep, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGroupLeft(t *testing.T) {
+	tests := []struct {
+		input    string
+		size     int
+		sep      string
+		expected string
+	}{
+		{input: "GB82WEST12345698765432", size: 4, sep: " ", expected: "GB82 WEST 1234 5698 7654 32"},
+		{input: "1234", size: 4, sep: " ", expected: "1234"},
+		{input: "abcdef", size: 2, sep: "-", expected: "ab-cd-ef"},
+		{input: "abc", size: -1, sep: "-", expected: "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := sx.GroupLeft(tt.input, tt.size, tt.sep)
+			if result != tt.expected {
+				t.Errorf("GroupLeft(%q, %d, %q) = %q, want %q", tt.input, tt.size, tt.sep, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         float64
+		locale    string
+		precision int
+		expected  string
+	}{
+		{name: "english", n: 1234567.891, locale: "en", precision: 2, expected: "1,234,567.89"},
+		{name: "german", n: 1234567.891, locale: "de", precision: 2, expected: "1.234.567,89"},
+		{name: "french", n: 1234567.891, locale: "fr", precision: 1, expected: "1\u202f234\u202f567,9"},
+		{name: "swiss german", n: 1234.5, locale: "de-CH", precision: 2, expected: "1’234.50"},
+		{name: "brazilian underscore tag", n: 1234.5, locale: "pt_BR", precision: 2, expected: "1.234,50"},
+		{name: "indian", n: 1234567.891, locale: "en-IN", precision: 0, expected: "12,34,568"},
+		{name: "indian crore", n: 123456789, locale: "hi", precision: 0, expected: "12,34,56,789"},
+		{name: "unknown locale", n: 1234, locale: "xx", precision: 0, expected: "1,234"},
+		{name: "small", n: 999, locale: "de", precision: 0, expected: "999"},
+		{name: "negative", n: -1234.5, locale: "en", precision: 1, expected: "-1,234.5"},
+		{name: "negative rounds to zero", n: -0.001, locale: "en", precision: 2, expected: "0.00"},
+		{name: "shortest", n: 1234.125, locale: "en", precision: -1, expected: "1,234.125"},
+		{name: "NaN", n: math.NaN(), locale: "en", precision: 2, expected: "NaN"},
+		{name: "infinity", n: math.Inf(-1), locale: "en", precision: 2, expected: "-∞"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.FormatNumber(tt.n, tt.locale, tt.precision)
+			if result != tt.expected {
+				t.Errorf("FormatNumber(%v, %q, %d) = %q, want %q", tt.n, tt.locale, tt.precision, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatNumber_RoundTrip(t *testing.T) {
+	for _, locale := range []string{"en", "de", "fr", "de-CH", "en-IN", "ru"} {
+		t.Run(locale, func(t *testing.T) {
+			formatted := sx.FormatNumber(-9876543.25, locale, 2)
+			parsed, err := sx.ParseFloatLenient(formatted, locale)
+			if err != nil || parsed != -9876543.25 {
+				t.Errorf("ParseFloatLenient(%q, %q) = %v, %v", formatted, locale, parsed, err)
+			}
+		})
+	}
+}
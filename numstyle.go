@@ -0,0 +1,86 @@
+package sx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NumberStyle controls how the case converters render runs of digits
+type NumberStyle int
+
+const (
+	// NumberAttached keeps digits in the word they appear in: html5-parser
+	NumberAttached NumberStyle = iota
+	// NumberSeparated makes every run of digits a word of its own: html-5-parser
+	NumberSeparated
+	// NumberSpelled replaces every run of digits with the English words
+	// spelling out the number: html-five-parser
+	NumberSpelled
+)
+
+// WithNumberWordStyle sets how the case converters render runs of digits
+// (default NumberAttached). Registered acronyms and protected words keep
+// their digits. SplitByCase is not affected.
+//
+// Example:
+//
+//	KebabCase("HTML5Parser")                                                // html5-parser
+//	DelimitedCase("HTML5Parser", "-", WithNumberWordStyle(NumberSeparated)) // html-5-parser
+//	DelimitedCase("HTML5Parser", "-", WithNumberWordStyle(NumberSpelled))   // html-five-parser
+func WithNumberWordStyle(style NumberStyle) Option {
+	return func(c *Config) {
+		c.NumberStyle = style
+	}
+}
+
+// renderNumbers returns words with their runs of digits rendered according to
+// the number style
+func (c *Config) renderNumbers(words []string) []string {
+	if c.NumberStyle == NumberAttached {
+		return words
+	}
+
+	result := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, ok := c.protected[word]; ok {
+			result = append(result, word)
+			continue
+		}
+		if _, ok := c.acronym(word); ok {
+			result = append(result, word)
+			continue
+		}
+
+		start, digits := 0, false
+		for i, r := range word {
+			if i > 0 && isDigit(r) != digits {
+				result = c.appendNumberRun(result, word[start:i], digits)
+				start = i
+			}
+			digits = isDigit(r)
+		}
+		result = c.appendNumberRun(result, word[start:], digits)
+	}
+	return result
+}
+
+// appendNumberRun appends run, a part of a word that is all digits or has
+// none, as one or more words
+func (c *Config) appendNumberRun(dst []string, run string, digits bool) []string {
+	if !digits || c.NumberStyle != NumberSpelled {
+		return append(dst, run)
+	}
+
+	// Numbers with leading zeros, like 007, are spelled digit by digit
+	if n, err := strconv.ParseInt(run, 10, 64); err == nil && (len(run) == 1 || run[0] != '0') {
+		return append(dst, strings.FieldsFunc(numberWords(n), func(r rune) bool { return r == ' ' || r == '-' })...)
+	}
+	for _, r := range run {
+		if '0' <= r && r <= '9' {
+			dst = append(dst, smallNumberWords[r-'0'])
+		} else {
+			dst = append(dst, string(r))
+		}
+	}
+	return dst
+}
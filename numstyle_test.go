@@ -0,0 +1,49 @@
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWithNumberWordStyle(t *testing.T) {
+	separated := sx.WithNumberWordStyle(sx.NumberSeparated)
+	spelled := sx.WithNumberWordStyle(sx.NumberSpelled)
+
+	tests := []struct {
+		name     string
+		function func(string) string
+		input    string
+		expected string
+	}{
+		{name: "attached", function: func(s string) string { return sx.KebabCase(s) }, input: "HTML5Parser", expected: "html5-parser"},
+		{name: "separated", function: func(s string) string { return sx.DelimitedCase(s, "-", separated) }, input: "HTML5Parser", expected: "html-5-parser"},
+		{name: "spelled", function: func(s string) string { return sx.DelimitedCase(s, "-", spelled) }, input: "HTML5Parser", expected: "html-five-parser"},
+		{name: "separated inside word", function: func(s string) string { return sx.SnakeCase(s, separated) }, input: "get2fa", expected: "get_2_fa"},
+		{name: "separated already split", function: func(s string) string { return sx.SnakeCase(s, separated) }, input: "v_2", expected: "v_2"},
+		{name: "spelled compound", function: func(s string) string { return sx.SnakeCase(s, spelled) }, input: "top42List", expected: "top_forty_two_list"},
+		{name: "attached large", function: func(s string) string { return sx.KebabCase(s) }, input: "year2024", expected: "year2024"},
+		{name: "spelled scale", function: func(s string) string { return sx.DelimitedCase(s, "-", spelled) }, input: "year2024", expected: "year-two-thousand-twenty-four"},
+		{name: "spelled leading zeros", function: func(s string) string { return sx.SnakeCase(s, spelled) }, input: "agent007", expected: "agent_zero_zero_seven"},
+		{name: "pascal separated", function: func(s string) string { return sx.PascalCase(s, separated) }, input: "html5_parser", expected: "Html5Parser"},
+		{name: "pascal spelled", function: func(s string) string { return sx.PascalCase(s, spelled) }, input: "html5_parser", expected: "HtmlFiveParser"},
+		{name: "camel spelled", function: func(s string) string { return sx.CamelCase(s, spelled) }, input: "3d_model", expected: "threeDModel"},
+		{name: "train separated", function: func(s string) string { return sx.TrainCase(s, separated) }, input: "ipv6_addr", expected: "Ipv-6-Addr"},
+		{name: "acronym kept", function: func(s string) string { return sx.SnakeCase(s, spelled, sx.WithAcronyms("UTF8")) }, input: "UTF8String", expected: "utf8_string"},
+		{name: "protected word kept", function: func(s string) string { return sx.SnakeCase(s, spelled, sx.WithProtectedWords("OAuth2")) }, input: "OAuth2Token", expected: "OAuth2_token"},
+		{name: "slice input", function: func(s string) string { return sx.SnakeCase(sx.SplitByCase(s), spelled) }, input: "page2", expected: "page_two"},
+		{name: "no digits", function: func(s string) string { return sx.SnakeCase(s, spelled) }, input: "foo_bar", expected: "foo_bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.function(tt.input); result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if words := sx.SplitByCase("HTML5Parser", separated); len(words) != 2 {
+		t.Errorf("SplitByCase = %q, want digits kept in their word", words)
+	}
+}
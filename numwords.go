@@ -0,0 +1,99 @@
+package sx
+
+import "strings"
+
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	tensWords = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	scaleWords = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	}
+	// irregularOrdinals maps number words whose ordinal is not formed by adding "th"
+	irregularOrdinals = map[string]string{
+		"one": "first", "two": "second", "three": "third", "five": "fifth",
+		"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+	}
+)
+
+// numberWords spells out n in American English, such as
+// "one hundred twenty-three" or "minus forty-two"
+func numberWords(n int64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+
+	var parts []string
+	u := uint64(n)
+	if n < 0 {
+		parts = append(parts, "minus")
+		u = -u
+	}
+
+	// Split into groups of three digits, most significant first
+	var groups []uint64
+	for ; u > 0; u /= 1000 {
+		groups = append(groups, u%1000)
+	}
+	for scale := len(groups) - 1; scale >= 0; scale-- {
+		if groups[scale] == 0 {
+			continue
+		}
+		parts = append(parts, hundredsWords(groups[scale]))
+		if scaleWords[scale] != "" {
+			parts = append(parts, scaleWords[scale])
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// hundredsWords spells out 1 <= n <= 999
+func hundredsWords(n uint64) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		parts = append(parts, smallNumberWords[n])
+	case n%10 == 0:
+		parts = append(parts, tensWords[n/10])
+	default:
+		parts = append(parts, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+	return strings.Join(parts, " ")
+}
+
+// ordinalWord converts the final cardinal word of a number to its ordinal
+func ordinalWord(word string) string {
+	if ordinal, ok := irregularOrdinals[word]; ok {
+		return ordinal
+	}
+	if base, ok := strings.CutSuffix(word, "y"); ok {
+		return base + "ieth"
+	}
+	return word + "th"
+}
+
+// OrdinalWords spells out n as an English ordinal, hyphenating compounds
+//
+// Example:
+//
+//	OrdinalWords(3)   // "third"
+//	OrdinalWords(21)  // "twenty-first"
+//	OrdinalWords(100) // "one hundredth"
+func OrdinalWords(n int) string {
+	words := numberWords(int64(n))
+
+	// Only the last word, or the last part of a hyphenated compound, changes
+	split := max(strings.LastIndexByte(words, ' '), strings.LastIndexByte(words, '-')) + 1
+	return words[:split] + ordinalWord(words[split:])
+}
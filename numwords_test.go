@@ -0,0 +1,47 @@
+package sx_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestOrdinalWords(t *testing.T) {
+	tests := []struct {
+		input    int
+		expected string
+	}{
+		{input: 0, expected: "zeroth"},
+		{input: 1, expected: "first"},
+		{input: 2, expected: "second"},
+		{input: 3, expected: "third"},
+		{input: 4, expected: "fourth"},
+		{input: 5, expected: "fifth"},
+		{input: 8, expected: "eighth"},
+		{input: 9, expected: "ninth"},
+		{input: 11, expected: "eleventh"},
+		{input: 12, expected: "twelfth"},
+		{input: 20, expected: "twentieth"},
+		{input: 21, expected: "twenty-first"},
+		{input: 42, expected: "forty-second"},
+		{input: 99, expected: "ninety-ninth"},
+		{input: 100, expected: "one hundredth"},
+		{input: 101, expected: "one hundred first"},
+		{input: 1000, expected: "one thousandth"},
+		{input: 1012, expected: "one thousand twelfth"},
+		{input: 2000000, expected: "two millionth"},
+		{input: 123456, expected: "one hundred twenty-three thousand four hundred fifty-sixth"},
+		{input: -3, expected: "minus third"},
+		{input: math.MinInt64, expected: "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eighth"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := sx.OrdinalWords(tt.input)
+			if result != tt.expected {
+				t.Errorf("OrdinalWords(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// PairOption configures how ParsePairs splits its input
+type PairOption func(*PairConfig)
+
+// PairConfig holds the configuration for ParsePairs
+type PairConfig struct {
+	// PairSeparator separates pairs from each other
+	PairSeparator rune
+	// KeyValueSeparator separates a key from its value
+	KeyValueSeparator rune
+}
+
+// defaultPairConfig returns the default configuration
+func defaultPairConfig() *PairConfig {
+	return &PairConfig{
+		PairSeparator:     ',',
+		KeyValueSeparator: '=',
+	}
+}
+
+// WithPairSeparator sets the rune separating pairs (default ',')
+func WithPairSeparator(sep rune) PairOption {
+	return func(c *PairConfig) {
+		c.PairSeparator = sep
+	}
+}
+
+// WithKeyValueSeparator sets the rune separating keys from values (default '=')
+func WithKeyValueSeparator(sep rune) PairOption {
+	return func(c *PairConfig) {
+		c.KeyValueSeparator = sep
+	}
+}
+
+// ParsePairs parses a list of key/value pairs such as `k1=v1,k2="v, 2"`.
+// Values (and keys) may be wrapped in single or double quotes to include
+// separators; inside quotes a backslash escapes the next character.
+// Unquoted keys and values are trimmed of surrounding whitespace and empty
+// pairs are skipped. Later duplicates overwrite earlier ones. Malformed input
+// returns a *SyntaxError.
+func ParsePairs(s string, opts ...PairOption) (map[string]string, error) {
+	config := defaultPairConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	result := make(map[string]string)
+	i := 0
+	for i < len(s) {
+		key, next, err := scanPairToken(s, i, config.KeyValueSeparator, config.PairSeparator)
+		if err != nil {
+			return nil, err
+		}
+
+		if next >= len(s) || !strings.HasPrefix(s[next:], string(config.KeyValueSeparator)) {
+			if key == "" {
+				// Empty pair, e.g. a trailing or doubled separator
+				i = next + utf8.RuneLen(config.PairSeparator)
+				continue
+			}
+			return nil, &SyntaxError{Msg: fmt.Sprintf("missing %q after key %q", config.KeyValueSeparator, key), Offset: next}
+		}
+		if key == "" {
+			return nil, &SyntaxError{Msg: "empty key", Offset: i}
+		}
+
+		value, end, err := scanPairToken(s, next+utf8.RuneLen(config.KeyValueSeparator), config.PairSeparator)
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = value
+		i = end + utf8.RuneLen(config.PairSeparator)
+	}
+
+	return result, nil
+}
+
+// scanPairToken reads a possibly quoted token starting at offset i, stopping at
+// any of the stop runes outside quotes. It returns the token and the offset of
+// the stop rune (or len(s)).
+func scanPairToken(s string, i int, stops ...rune) (string, int, error) {
+	var token strings.Builder
+	quoted := false
+
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '"' || r == '\'' {
+			if quoted || strings.TrimSpace(token.String()) != "" {
+				return "", 0, &SyntaxError{Msg: "unexpected quote", Offset: i}
+			}
+			value, end, err := scanQuoted(s, i)
+			if err != nil {
+				return "", 0, err
+			}
+			token.Reset()
+			token.WriteString(value)
+			quoted = true
+			i = end
+			continue
+		}
+
+		if slices.Contains(stops, r) {
+			break
+		}
+		if quoted && r != ' ' && r != '\t' {
+			return "", 0, &SyntaxError{Msg: "unexpected character after quoted value", Offset: i}
+		}
+		if !quoted {
+			token.WriteRune(r)
+		}
+		i += size
+	}
+
+	if quoted {
+		return token.String(), i, nil
+	}
+	return strings.TrimSpace(token.String()), i, nil
+}
+
+// scanQuoted reads a quoted string starting at s[i] and returns its unescaped
+// content and the offset just past the closing quote
+func scanQuoted(s string, i int) (string, int, error) {
+	quote := s[i]
+	var value strings.Builder
+	for j := i + 1; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			if j+1 < len(s) {
+				j++
+				value.WriteByte(s[j])
+			}
+		case quote:
+			return value.String(), j + 1, nil
+		default:
+			value.WriteByte(s[j])
+		}
+	}
+	return "", 0, &SyntaxError{Msg: "unterminated quote", Offset: i}
+}
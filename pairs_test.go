@@ -0,0 +1,111 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestParsePairs(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.PairOption
+		expected map[string]string
+	}{
+		{
+			name:     "simple",
+			input:    "k1=v1,k2=v2",
+			expected: map[string]string{"k1": "v1", "k2": "v2"},
+		},
+		{
+			name:     "quoted separator",
+			input:    `k1=v1,k2="v, 2"`,
+			expected: map[string]string{"k1": "v1", "k2": "v, 2"},
+		},
+		{
+			name:     "single quotes and escapes",
+			input:    `a='it\'s', b="say \"hi\""`,
+			expected: map[string]string{"a": "it's", "b": `say "hi"`},
+		},
+		{
+			name:     "whitespace trimmed",
+			input:    " host = db.local , port= 5432 ",
+			expected: map[string]string{"host": "db.local", "port": "5432"},
+		},
+		{
+			name:     "empty values and pairs",
+			input:    "a=,,b=2,",
+			expected: map[string]string{"a": "", "b": "2"},
+		},
+		{
+			name:     "value containing kv separator",
+			input:    "url=postgres://u@h/db?sslmode=off",
+			expected: map[string]string{"url": "postgres://u@h/db?sslmode=off"},
+		},
+		{
+			name:     "connection string",
+			input:    "host=localhost user=app password='p w'",
+			options:  []sx.PairOption{sx.WithPairSeparator(' ')},
+			expected: map[string]string{"host": "localhost", "user": "app", "password": "p w"},
+		},
+		{
+			name:     "custom kv separator",
+			input:    "env:prod;tier:web",
+			options:  []sx.PairOption{sx.WithPairSeparator(';'), sx.WithKeyValueSeparator(':')},
+			expected: map[string]string{"env": "prod", "tier": "web"},
+		},
+		{
+			name:     "duplicate keys",
+			input:    "a=1,a=2",
+			expected: map[string]string{"a": "2"},
+		},
+		{
+			name:     "empty",
+			input:    "",
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.ParsePairs(tt.input, tt.options...)
+			if err != nil {
+				t.Fatalf("ParsePairs(%q) error = %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParsePairs(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParsePairs_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+	}{
+		{name: "missing separator", input: "a=1,b", offset: 5},
+		{name: "empty key", input: "=1", offset: 0},
+		{name: "unterminated quote", input: `a="oops`, offset: 2},
+		{name: "text after quote", input: `a="x"y`, offset: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sx.ParsePairs(tt.input)
+			var syntaxErr *sx.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParsePairs(%q) error = %v, want *sx.SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Offset != tt.offset {
+				t.Errorf("ParsePairs(%q) error offset = %d, want %d", tt.input, syntaxErr.Offset, tt.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"maps"
+	"strings"
+	"unicode/utf8"
+)
+
+// PalindromeOption configures IsPalindrome
+type PalindromeOption func(*PalindromeConfig)
+
+// PalindromeConfig holds the configuration for IsPalindrome
+type PalindromeConfig struct {
+	// IgnoreCase compares characters case-insensitively
+	IgnoreCase bool
+	// IgnorePunctuation skips everything except letters and digits, including spaces
+	IgnorePunctuation bool
+}
+
+// defaultPalindromeConfig returns the default, strict configuration
+func defaultPalindromeConfig() *PalindromeConfig {
+	return &PalindromeConfig{}
+}
+
+// WithIgnoreCase makes IsPalindrome compare characters case-insensitively
+func WithIgnoreCase() PalindromeOption {
+	return func(c *PalindromeConfig) {
+		c.IgnoreCase = true
+	}
+}
+
+// WithIgnorePunctuation makes IsPalindrome skip spaces, punctuation and
+// symbols, so only letters and digits are compared
+func WithIgnorePunctuation() PalindromeOption {
+	return func(c *PalindromeConfig) {
+		c.IgnorePunctuation = true
+	}
+}
+
+// IsPalindrome reports whether s reads the same forwards and backwards.
+// Comparison works on grapheme clusters, so accented letters written with
+// combining marks and emoji sequences are treated as single characters.
+//
+// Example:
+//
+//	IsPalindrome("A man, a plan, a canal: Panama", WithIgnoreCase(), WithIgnorePunctuation()) // true
+func IsPalindrome(s string, opts ...PalindromeOption) bool {
+	config := defaultPalindromeConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	var clusters []string
+	for _, g := range splitGraphemes(s) {
+		if config.IgnorePunctuation {
+			r, _ := utf8.DecodeRuneInString(g)
+			if !isLetterOrDigit(r) {
+				continue
+			}
+		}
+		if config.IgnoreCase {
+			g = strings.Map(foldRune, g)
+		}
+		clusters = append(clusters, g)
+	}
+
+	for i, j := 0, len(clusters)-1; i < j; i, j = i+1, j-1 {
+		if clusters[i] != clusters[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsAnagram reports whether a and b use exactly the same letters and digits,
+// ignoring case, spaces and punctuation. For example "Dormitory" and
+// "dirty room!" are anagrams.
+func IsAnagram(a, b string) bool {
+	return maps.Equal(anagramCounts(a), anagramCounts(b))
+}
+
+// anagramCounts counts the case-folded letters and digits of s
+func anagramCounts(s string) map[rune]int {
+	counts := make(map[rune]int)
+	for _, r := range s {
+		if isLetterOrDigit(r) {
+			counts[foldRune(r)]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,66 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestIsPalindrome(t *testing.T) {
+	loose := []sx.PalindromeOption{sx.WithIgnoreCase(), sx.WithIgnorePunctuation()}
+
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.PalindromeOption
+		expected bool
+	}{
+		{name: "simple", input: "racecar", expected: true},
+		{name: "not palindrome", input: "hello", expected: false},
+		{name: "empty", input: "", expected: true},
+		{name: "single", input: "x", expected: true},
+		{name: "case sensitive by default", input: "Racecar", expected: false},
+		{name: "ignore case", input: "Racecar", options: []sx.PalindromeOption{sx.WithIgnoreCase()}, expected: true},
+		{name: "punctuation counts by default", input: "race car", expected: false},
+		{name: "sentence", input: "A man, a plan, a canal: Panama", options: loose, expected: true},
+		{name: "digits", input: "12 3 21", options: loose, expected: true},
+		{name: "combining marks", input: "e\u0301ae\u0301", expected: true},
+		{name: "emoji sequence", input: "a\U0001F44D\U0001F3FDa", expected: true},
+		{name: "cyrillic ignore case", input: "Аба", options: loose, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.IsPalindrome(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("IsPalindrome(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsAnagram(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{name: "simple", a: "listen", b: "silent", expected: true},
+		{name: "case and spaces", a: "Dormitory", b: "dirty room!", expected: true},
+		{name: "different counts", a: "aab", b: "abb", expected: false},
+		{name: "different letters", a: "abc", b: "abd", expected: false},
+		{name: "empty", a: "", b: "  ", expected: true},
+		{name: "unicode", a: "\u00c9cole", b: "loc\u00e9e", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.IsAnagram(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("IsAnagram(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelChunk is the number of consecutive strings a worker claims at a
+// time, so workers rarely contend on the shared counter
+const parallelChunk = 256
+
+// MapParallel applies fn to every string of ss using a pool of workers and
+// returns the results in input order. workers <= 0 uses runtime.GOMAXPROCS.
+// fn must be safe for concurrent use. It pays off for expensive transforms
+// such as transliteration or similarity scoring over large inputs; for cheap
+// ones a plain loop is faster.
+//
+// Example:
+//
+//	slugs := MapParallel(titles, 0, func(s string) string { return KebabCase(s) })
+func MapParallel(ss []string, workers int, fn func(string) string) []string {
+	out, _ := MapParallelContext(context.Background(), ss, workers, fn)
+	return out
+}
+
+// MapParallelContext is like MapParallel, but stops handing out strings once
+// ctx is done and then returns nil and the context's error. Calls of fn
+// already running are not interrupted.
+func MapParallelContext(ctx context.Context, ss []string, workers int, fn func(string) string) ([]string, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	out := make([]string, len(ss))
+	workers = min(workers, (len(ss)+parallelChunk-1)/parallelChunk)
+	if workers <= 1 {
+		for i, s := range ss {
+			if i%parallelChunk == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+			}
+			out[i] = fn(s)
+		}
+		return out, nil
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for {
+				end := int(next.Add(parallelChunk))
+				start := end - parallelChunk
+				if start >= len(ss) || ctx.Err() != nil {
+					return
+				}
+				for i := start; i < min(end, len(ss)); i++ {
+					out[i] = fn(ss[i])
+				}
+			}
+		})
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,69 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestMapParallel(t *testing.T) {
+	large := make([]string, 10000)
+	for i := range large {
+		large[i] = fmt.Sprintf("itemNumber%d", i)
+	}
+
+	tests := []struct {
+		name    string
+		input   []string
+		workers int
+	}{
+		{name: "empty", input: nil, workers: 4},
+		{name: "small", input: []string{"fooBar", "bazQux"}, workers: 4},
+		{name: "large default workers", input: large, workers: 0},
+		{name: "large one worker", input: large, workers: 1},
+		{name: "large many workers", input: large, workers: 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.MapParallel(tt.input, tt.workers, strings.ToUpper)
+			expected := make([]string, len(tt.input))
+			for i, s := range tt.input {
+				expected[i] = strings.ToUpper(s)
+			}
+			if !slices.Equal(result, expected) {
+				t.Errorf("MapParallel() results differ from sequential mapping")
+			}
+		})
+	}
+}
+
+func TestMapParallelContext(t *testing.T) {
+	large := make([]string, 10000)
+	for i := range large {
+		large[i] = fmt.Sprintf("itemNumber%d", i)
+	}
+
+	result, err := sx.MapParallelContext(context.Background(), large, 4, strings.ToUpper)
+	if err != nil {
+		t.Fatalf("MapParallelContext() error = %v", err)
+	}
+	if !slices.Equal(result, sx.MapParallel(large, 4, strings.ToUpper)) {
+		t.Errorf("MapParallelContext() results differ from MapParallel()")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, workers := range []int{1, 4} {
+		if result, err := sx.MapParallelContext(ctx, large, workers, strings.ToUpper); !errors.Is(err, context.Canceled) || result != nil {
+			t.Errorf("MapParallelContext(canceled, %d workers) = %d results, %v, want nil, context.Canceled", workers, len(result), err)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseBoolLenient parses human-friendly boolean values, case-insensitively
+// and ignoring surrounding whitespace. Accepted true values are 1, t, true,
+// y, yes, on, enable and enabled; false values are 0, f, false, n, no, off,
+// disable and disabled. Other input returns a *strconv.NumError wrapping
+// strconv.ErrSyntax, like strconv.ParseBool.
+func ParseBoolLenient(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "t", "true", "y", "yes", "on", "enable", "enabled":
+		return true, nil
+	case "0", "f", "false", "n", "no", "off", "disable", "disabled":
+		return false, nil
+	}
+	return false, &strconv.NumError{Func: "ParseBoolLenient", Num: s, Err: strconv.ErrSyntax}
+}
+
+// groupSeparators are runes commonly used to group digits in any locale
+const groupSeparators = ", '’_ \u00A0\u2009\u202F"
+
+// ParseIntLenient parses an integer that may contain digit grouping
+// separators, such as "1,234,567", "1 234 567" or "1'234'567". A number must
+// use a single separator throughout and groups of three digits after the
+// first, so "1.5", "12,3", "12,34,567" and "1,234 567" are rejected rather
+// than silently misread. "." is a decimal point in many locales and is never
+// taken as a separator; use ParseIntLenientLocale to parse "1.234.567" in
+// "de" or Indian "12,34,567" in "en-IN".
+func ParseIntLenient(s string) (int64, error) {
+	return parseIntGrouped("ParseIntLenient", s, groupSeparators, 0)
+}
+
+// ParseIntLenientLocale is like ParseIntLenient, but also accepts the
+// grouping separator of locale (e.g. "en", "de", "fr-CH") and rejects its
+// decimal separator, so "1.234.567" in "de" yields 1234567 while "1.234" in
+// "en" is an error. Locales with lakh and crore grouping, such as "en-IN"
+// and "hi", also accept two-digit groups before the last three digits.
+func ParseIntLenientLocale(s string, locale string) (int64, error) {
+	format := lookupNumberFormat(locale)
+	return parseIntGrouped("ParseIntLenientLocale", s, localeGroupSeparators(format), format.secondary)
+}
+
+// parseIntGrouped implements ParseIntLenient and ParseIntLenientLocale,
+// reporting errors as coming from fn
+func parseIntGrouped(fn, s, separators string, secondary int) (int64, error) {
+	digits, ok := stripGrouping(strings.TrimSpace(s), separators, secondary)
+	if !ok {
+		return 0, &strconv.NumError{Func: fn, Num: s, Err: strconv.ErrSyntax}
+	}
+
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, &strconv.NumError{Func: fn, Num: s, Err: err.(*strconv.NumError).Err}
+	}
+	return n, nil
+}
+
+// ParseFloatLenient parses a number written in the conventions of locale
+// (e.g. "en", "de", "fr-CH"): grouping separators are removed and the
+// locale's decimal separator is honored, so "1 234,56" in "fr" and
+// "1.234,56" in "de" both yield 1234.56. The integer part may be left out,
+// as in ".5" or "-.5".
+func ParseFloatLenient(s string, locale string) (float64, error) {
+	format := lookupNumberFormat(locale)
+
+	intPart, frac, hasFrac := strings.Cut(strings.TrimSpace(s), format.decimal)
+	separators := localeGroupSeparators(format)
+
+	digits, ok := intPart, hasFrac && (intPart == "" || intPart == "-" || intPart == "+")
+	if !ok {
+		digits, ok = stripGrouping(intPart, separators, format.secondary)
+	}
+	if !ok || strings.ContainsAny(frac, separators+format.decimal) {
+		return 0, &strconv.NumError{Func: "ParseFloatLenient", Num: s, Err: strconv.ErrSyntax}
+	}
+	if hasFrac {
+		digits += "." + frac
+	}
+
+	f, err := strconv.ParseFloat(digits, 64)
+	if err != nil {
+		return 0, &strconv.NumError{Func: "ParseFloatLenient", Num: s, Err: err.(*strconv.NumError).Err}
+	}
+	return f, nil
+}
+
+// localeGroupSeparators returns the grouping separators accepted in format:
+// the common ones plus the locale's own, minus its decimal separator
+func localeGroupSeparators(format numberFormat) string {
+	separators := groupSeparators
+	if format.group != "" && !strings.Contains(separators, format.group) {
+		separators += format.group
+	}
+	return strings.ReplaceAll(separators, format.decimal, "")
+}
+
+// stripGrouping removes digit grouping separators from an optionally signed
+// run of digits, verifying that the groups before the last three digits have
+// three digits each, or secondary digits each when secondary is not 0 (as in
+// Indian 12,34,567 grouping), the first possibly fewer. All separators must
+// be the same rune.
+func stripGrouping(s, separators string, secondary int) (string, bool) {
+	var digits strings.Builder
+	var groups []int
+	groupLen := 0
+	var separator rune
+
+	for i, r := range s {
+		switch {
+		case (r == '-' || r == '+') && i == 0:
+			digits.WriteRune(r)
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+			groupLen++
+		case strings.ContainsRune(separators, r):
+			if groupLen == 0 || len(groups) > 0 && r != separator {
+				return "", false
+			}
+			separator = r
+			groups = append(groups, groupLen)
+			groupLen = 0
+		default:
+			return "", false
+		}
+	}
+
+	if groupLen == 0 {
+		return "", false
+	}
+	if len(groups) > 0 && (groupLen != 3 || !groupedBy(groups, 3) && (secondary == 0 || !groupedBy(groups, secondary))) {
+		return "", false
+	}
+	return digits.String(), true
+}
+
+// groupedBy reports whether the digit groups before the last all have size
+// digits, except the first, which may have fewer
+func groupedBy(groups []int, size int) bool {
+	if groups[0] > size {
+		return false
+	}
+	for _, n := range groups[1:] {
+		if n != size {
+			return false
+		}
+	}
+	return true
+}
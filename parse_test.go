@@ -0,0 +1,179 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestParseBoolLenient(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+		wantErr  bool
+	}{
+		{input: "yes", expected: true},
+		{input: "Y", expected: true},
+		{input: " ON ", expected: true},
+		{input: "Enabled", expected: true},
+		{input: "1", expected: true},
+		{input: "TRUE", expected: true},
+		{input: "no", expected: false},
+		{input: "n", expected: false},
+		{input: "Off", expected: false},
+		{input: "disabled", expected: false},
+		{input: "0", expected: false},
+		{input: "f", expected: false},
+		{input: "maybe", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := sx.ParseBoolLenient(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, strconv.ErrSyntax) {
+					t.Errorf("ParseBoolLenient(%q) error = %v, want strconv.ErrSyntax", tt.input, err)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("ParseBoolLenient(%q) = %v, %v, want %v", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseIntLenient(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{input: "1,234,567", expected: 1234567},
+		{input: "1 234 567", expected: 1234567},
+		{input: "1'234", expected: 1234},
+		{input: "1 234", expected: 1234},
+		{input: "12,34,567", wantErr: true},
+		{input: "1,234,56,789", wantErr: true},
+		{input: "1,2345,678", wantErr: true},
+		{input: "-42", expected: -42},
+		{input: " 7 ", expected: 7},
+		{input: "1.5", wantErr: true},
+		{input: "12,3", wantErr: true},
+		{input: "1,,234", wantErr: true},
+		{input: ",123", wantErr: true},
+		{input: "1234,567", wantErr: true},
+		{input: "12a", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "99,999,999,999,999,999,999", wantErr: true},
+		{input: "1.234", wantErr: true},
+		{input: "1.234.567", wantErr: true},
+		{input: "1,234.567", wantErr: true},
+		{input: "1.234,567", wantErr: true},
+		{input: "1,234 567", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := sx.ParseIntLenient(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseIntLenient(%q) = %d, want error", tt.input, result)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("ParseIntLenient(%q) = %d, %v, want %d", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseIntLenientLocale(t *testing.T) {
+	tests := []struct {
+		input    string
+		locale   string
+		expected int64
+		wantErr  bool
+	}{
+		{input: "1.234.567", locale: "de", expected: 1234567},
+		{input: "1,234,567", locale: "en", expected: 1234567},
+		{input: "1 234 567", locale: "fr", expected: 1234567},
+		{input: "12,34,567", locale: "en-IN", expected: 1234567},
+		{input: "1,23,45,678", locale: "hi", expected: 12345678},
+		{input: "1,234,567", locale: "en-IN", expected: 1234567},
+		{input: "1,234,56,789", locale: "en", wantErr: true},
+		{input: "1,234,56,789", locale: "en-IN", wantErr: true},
+		{input: "12,34,567", locale: "en", wantErr: true},
+		{input: "1.234", locale: "en", wantErr: true},
+		{input: "1,234", locale: "de", wantErr: true},
+		{input: "1.234,567", locale: "de", wantErr: true},
+		{input: "1.234 567", locale: "de", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input+"/"+tt.locale, func(t *testing.T) {
+			result, err := sx.ParseIntLenientLocale(tt.input, tt.locale)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseIntLenientLocale(%q, %q) = %d, want error", tt.input, tt.locale, result)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("ParseIntLenientLocale(%q, %q) = %d, %v, want %d", tt.input, tt.locale, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFloatLenient(t *testing.T) {
+	tests := []struct {
+		input    string
+		locale   string
+		expected float64
+		wantErr  bool
+	}{
+		{input: "1,234.56", locale: "en", expected: 1234.56},
+		{input: "1.234,56", locale: "de", expected: 1234.56},
+		{input: "1 234,56", locale: "fr", expected: 1234.56},
+		{input: "1 234,5", locale: "fr-FR", expected: 1234.5},
+		{input: "1’234.5", locale: "de-CH", expected: 1234.5},
+		{input: "1.234,56", locale: "pt_BR", expected: 1234.56},
+		{input: "-0,5", locale: "de", expected: -0.5},
+		{input: "42", locale: "", expected: 42},
+		{input: "3.14", locale: "unknown", expected: 3.14},
+		{input: "1,5", locale: "en", wantErr: true},
+		{input: "1.2.3", locale: "en", wantErr: true},
+		{input: "abc", locale: "en", wantErr: true},
+		{input: ".5", locale: "en", expected: 0.5},
+		{input: "-.5", locale: "en", expected: -0.5},
+		{input: "+,25", locale: "de", expected: 0.25},
+		{input: "12,34,567.5", locale: "en-IN", expected: 1234567.5},
+		{input: "12,34,567.5", locale: "en", wantErr: true},
+		{input: ".", locale: "en", wantErr: true},
+		{input: "-", locale: "en", wantErr: true},
+		{input: "-.", locale: "en", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input+"/"+tt.locale, func(t *testing.T) {
+			result, err := sx.ParseFloatLenient(tt.input, tt.locale)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseFloatLenient(%q, %q) = %v, want error", tt.input, tt.locale, result)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("ParseFloatLenient(%q, %q) = %v, %v, want %v", tt.input, tt.locale, result, err, tt.expected)
+			}
+		})
+	}
+}
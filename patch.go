@@ -0,0 +1,158 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ApplyPatch applies an edit script, as returned by DiffLines or
+// ParseUnifiedDiff, to s. Lines between edits are copied unchanged, so the
+// script only needs to cover the changed regions. Equal and deleted lines
+// must match s exactly; otherwise an error wrapping ErrPatchConflict is
+// returned.
+//
+// Example:
+//
+//	edits := DiffLines("a\nb\n", "a\nc\n")
+//	ApplyPatch("a\nb\n", edits) // "a\nc\n", nil
+func ApplyPatch(s string, diff []Edit) (string, error) {
+	lines := splitLines(s)
+
+	var result strings.Builder
+	pos := 0
+	for _, edit := range diff {
+		if edit.OldLine < pos || edit.OldLine > len(lines) {
+			return "", fmt.Errorf("%w: edit at line %d is out of order or beyond the end", ErrPatchConflict, edit.OldLine+1)
+		}
+		for ; pos < edit.OldLine; pos++ {
+			result.WriteString(lines[pos])
+		}
+
+		if edit.Op == EditInsert {
+			result.WriteString(edit.Text)
+			continue
+		}
+		if pos == len(lines) || lines[pos] != edit.Text {
+			return "", fmt.Errorf("%w: line %d does not match %q", ErrPatchConflict, pos+1, edit.Text)
+		}
+		if edit.Op == EditEqual {
+			result.WriteString(lines[pos])
+		}
+		pos++
+	}
+	for ; pos < len(lines); pos++ {
+		result.WriteString(lines[pos])
+	}
+
+	return result.String(), nil
+}
+
+// ParseUnifiedDiff parses a single-file unified diff, as produced by
+// UnifiedDiff, diff -u or git diff, into an edit script for ApplyPatch.
+// Header lines before the first hunk are skipped. A *SyntaxError is returned
+// for malformed hunks.
+func ParseUnifiedDiff(s string) ([]Edit, error) {
+	var edits []Edit
+	offset := 0
+	lines := splitLines(s)
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if !strings.HasPrefix(line, "@@") {
+			if len(edits) > 0 {
+				return nil, &SyntaxError{Msg: "unexpected line after hunk", Offset: offset}
+			}
+			offset += len(line)
+			i++
+			continue
+		}
+
+		oldLine, oldCount, newLine, newCount, ok := parseHunkHeader(line)
+		if !ok {
+			return nil, &SyntaxError{Msg: "invalid hunk header", Offset: offset}
+		}
+		offset += len(line)
+		i++
+
+		for oldCount > 0 || newCount > 0 {
+			if i == len(lines) {
+				return nil, &SyntaxError{Msg: "truncated hunk", Offset: offset}
+			}
+			line = lines[i]
+
+			// Some tools strip the space from blank context lines
+			op, text := byte(' '), "\n"
+			if line != "\n" {
+				op, text = line[0], line[1:]
+			}
+			edit := Edit{OldLine: oldLine, NewLine: newLine, Text: text}
+			switch {
+			case op == ' ' && oldCount > 0 && newCount > 0:
+				edit.Op = EditEqual
+				oldLine, oldCount = oldLine+1, oldCount-1
+				newLine, newCount = newLine+1, newCount-1
+			case op == '-' && oldCount > 0:
+				edit.Op = EditDelete
+				oldLine, oldCount = oldLine+1, oldCount-1
+			case op == '+' && newCount > 0:
+				edit.Op = EditInsert
+				newLine, newCount = newLine+1, newCount-1
+			default:
+				return nil, &SyntaxError{Msg: "unexpected line in hunk", Offset: offset}
+			}
+			edits = append(edits, edit)
+			offset += len(line)
+			i++
+
+			if i < len(lines) && strings.HasPrefix(lines[i], `\`) {
+				edits[len(edits)-1].Text = strings.TrimSuffix(text, "\n")
+				offset += len(lines[i])
+				i++
+			}
+		}
+	}
+
+	return edits, nil
+}
+
+// parseHunkHeader parses "@@ -l,s +l,s @@" into zero-based start lines and
+// line counts
+func parseHunkHeader(line string) (oldLine, oldCount, newLine, newCount int, ok bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || fields[0] != "@@" || fields[3] != "@@" ||
+		!strings.HasPrefix(fields[1], "-") || !strings.HasPrefix(fields[2], "+") {
+		return 0, 0, 0, 0, false
+	}
+
+	oldLine, oldCount, ok = parseHunkRange(fields[1][1:])
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+	newLine, newCount, ok = parseHunkRange(fields[2][1:])
+	return oldLine, oldCount, newLine, newCount, ok
+}
+
+// parseHunkRange parses "l,s" or "l" into a zero-based start line and count.
+// An empty range names the line before it, so its start is not adjusted.
+func parseHunkRange(s string) (start, count int, ok bool) {
+	startText, countText, hasCount := strings.Cut(s, ",")
+	start, err := strconv.Atoi(startText)
+	if err != nil || start < 0 {
+		return 0, 0, false
+	}
+	count = 1
+	if hasCount {
+		if count, err = strconv.Atoi(countText); err != nil || count < 0 {
+			return 0, 0, false
+		}
+	}
+	if count > 0 {
+		if start == 0 {
+			return 0, 0, false
+		}
+		start--
+	}
+	return start, count, true
+}
@@ -0,0 +1,150 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		context  int
+		target   string
+		expected string
+	}{
+		{name: "same text", a: "a\nb\nc\n", b: "a\nB\nc\n", context: 1, target: "a\nb\nc\n", expected: "a\nB\nc\n"},
+		{name: "lines outside hunks copied", a: "a\nb\nc\nd\ne\n", b: "a\nb\nC\nd\ne\n", context: 0, target: "a\nb\nc\nd\ne\n", expected: "a\nb\nC\nd\ne\n"},
+		{name: "append", a: "a\n", b: "a\nb\n", context: 3, target: "a\n", expected: "a\nb\n"},
+		{name: "missing final newline", a: "a\nb", b: "a\nb\n", context: 3, target: "a\nb", expected: "a\nb\n"},
+		{name: "to empty", a: "a\nb\n", b: "", context: 3, target: "a\nb\n", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edits, err := sx.ParseUnifiedDiff(sx.UnifiedDiff(tt.a, tt.b, sx.WithContext(tt.context)))
+			if err != nil {
+				t.Fatalf("ParseUnifiedDiff error = %v", err)
+			}
+			result, err := sx.ApplyPatch(tt.target, edits)
+			if err != nil {
+				t.Fatalf("ApplyPatch error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("ApplyPatch(%q) = %q, want %q", tt.target, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyPatch_Conflict(t *testing.T) {
+	edits := sx.DiffLines("a\nb\nc\n", "a\nB\nc\n")
+
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{name: "changed context", target: "x\nb\nc\n"},
+		{name: "changed deleted line", target: "a\nx\nc\n"},
+		{name: "too short", target: "a\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := sx.ApplyPatch(tt.target, edits); !errors.Is(err, sx.ErrPatchConflict) {
+				t.Errorf("ApplyPatch(%q) error = %v, want ErrPatchConflict", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestApplyPatch_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	randomText := func() string {
+		lines := make([]string, r.IntN(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + r.IntN(5)))
+		}
+		text := strings.Join(lines, "\n")
+		if r.IntN(2) == 0 && text != "" {
+			text += "\n"
+		}
+		return text
+	}
+
+	for range 300 {
+		a, b := randomText(), randomText()
+
+		if result, err := sx.ApplyPatch(a, sx.DiffLines(a, b)); err != nil || result != b {
+			t.Fatalf("ApplyPatch(%q, DiffLines) = %q, %v, want %q", a, result, err, b)
+		}
+
+		diff := sx.UnifiedDiff(a, b, sx.WithContext(r.IntN(4)))
+		edits, err := sx.ParseUnifiedDiff(diff)
+		if err != nil {
+			t.Fatalf("ParseUnifiedDiff(%q) error = %v", diff, err)
+		}
+		if result, err := sx.ApplyPatch(a, edits); err != nil || result != b {
+			t.Fatalf("ApplyPatch(%q, %q) = %q, %v, want %q", a, diff, result, err, b)
+		}
+	}
+}
+
+func TestParseUnifiedDiff(t *testing.T) {
+	diff := "diff --git a/f b/f\n" +
+		"index 1234567..89abcde 100644\n" +
+		"--- a/f\n" +
+		"+++ b/f\n" +
+		"@@ -2,2 +2,2 @@ func main() {\n" +
+		" x\n" +
+		"-y\n" +
+		"+z\n" +
+		"\\ No newline at end of file\n"
+
+	expected := []sx.Edit{
+		{Op: sx.EditEqual, OldLine: 1, NewLine: 1, Text: "x\n"},
+		{Op: sx.EditDelete, OldLine: 2, NewLine: 2, Text: "y\n"},
+		{Op: sx.EditInsert, OldLine: 3, NewLine: 2, Text: "z"},
+	}
+
+	edits, err := sx.ParseUnifiedDiff(diff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff error = %v", err)
+	}
+	if !reflect.DeepEqual(edits, expected) {
+		t.Errorf("ParseUnifiedDiff = %v, want %v", edits, expected)
+	}
+}
+
+func TestParseUnifiedDiff_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+	}{
+		{name: "bad header", input: "--- a\n+++ b\n@@ -x +1 @@\n", offset: 12},
+		{name: "truncated hunk", input: "@@ -1,2 +1,2 @@\n a\n", offset: 19},
+		{name: "unexpected line", input: "@@ -1 +1 @@\n?a\n", offset: 12},
+		{name: "trailing garbage", input: "@@ -1 +1 @@\n-a\n+b\nfoo\n", offset: 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sx.ParseUnifiedDiff(tt.input)
+			var syntaxErr *sx.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseUnifiedDiff(%q) error = %v, want *SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Offset != tt.offset {
+				t.Errorf("ParseUnifiedDiff(%q) offset = %d, want %d", tt.input, syntaxErr.Offset, tt.offset)
+			}
+		})
+	}
+}
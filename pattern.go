@@ -0,0 +1,75 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Pattern placeholders used by FormatPattern and MaskPattern
+const (
+	patternReveal = '#'
+	patternMask   = '*'
+)
+
+// FormatPattern fills the '#' placeholders of pattern with the letters and
+// digits of s in order; other pattern characters are copied literally, and
+// formatting characters in s are ignored. When s runs out, output stops after
+// the last filled placeholder, so partial input is formatted progressively.
+// Input beyond the last placeholder is dropped.
+//
+// Example:
+//
+//	FormatPattern("###-##-####", "123456789") // "123-45-6789"
+//	FormatPattern("(###) ###-####", "555.12") // "(555) 12"
+func FormatPattern(pattern, s string) string {
+	return applyPattern(pattern, s, false)
+}
+
+// MaskPattern formats s like FormatPattern, additionally replacing the
+// characters at '*' placeholders with '*', for displaying card numbers and
+// other identifiers with only some characters visible
+//
+// Example:
+//
+//	MaskPattern("4111 1111 1111 1234", "####-****-****-####") // "4111-****-****-1234"
+func MaskPattern(s, pattern string) string {
+	return applyPattern(pattern, s, true)
+}
+
+// applyPattern implements FormatPattern and MaskPattern; '*' is a masking
+// placeholder when mask is true and a literal otherwise
+func applyPattern(pattern, s string, mask bool) string {
+	var b strings.Builder
+	filled := 0
+	for _, p := range pattern {
+		if p != patternReveal && (!mask || p != patternMask) {
+			b.WriteRune(p)
+			continue
+		}
+
+		// Take the next letter or digit from s
+		var r rune
+		for s != "" {
+			var size int
+			r, size = utf8.DecodeRuneInString(s)
+			s = s[size:]
+			if isLetterOrDigit(r) {
+				break
+			}
+			r = 0
+		}
+		if r == 0 {
+			// Input ran out: drop the literals written since the last placeholder
+			return b.String()[:filled]
+		}
+
+		if p == patternMask {
+			r = patternMask
+		}
+		b.WriteRune(r)
+		filled = b.Len()
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestFormatPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		input    string
+		expected string
+	}{
+		{name: "ssn", pattern: "###-##-####", input: "123456789", expected: "123-45-6789"},
+		{name: "phone", pattern: "(###) ###-####", input: "555.123.4567", expected: "(555) 123-4567"},
+		{name: "partial", pattern: "(###) ###-####", input: "555.12", expected: "(555) 12"},
+		{name: "partial at group end", pattern: "###-##-####", input: "123", expected: "123"},
+		{name: "extra input dropped", pattern: "##/##", input: "123456", expected: "12/34"},
+		{name: "trailing literal", pattern: "### kg", input: "120", expected: "120 kg"},
+		{name: "letters", pattern: "## ## ###", input: "ab-12-xyz", expected: "ab 12 xyz"},
+		{name: "star is literal", pattern: "#*#", input: "12", expected: "1*2"},
+		{name: "empty input", pattern: "###", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.FormatPattern(tt.pattern, tt.input)
+			if result != tt.expected {
+				t.Errorf("FormatPattern(%q, %q) = %q, want %q", tt.pattern, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaskPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		pattern  string
+		expected string
+	}{
+		{name: "card", input: "4111 1111 1111 1234", pattern: "####-****-****-####", expected: "4111-****-****-1234"},
+		{name: "ssn", input: "123-45-6789", pattern: "***-**-####", expected: "***-**-6789"},
+		{name: "short input", input: "12345", pattern: "**** ####", expected: "**** 5"},
+		{name: "unicode", input: "日本語", pattern: "#*#", expected: "日*語"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.MaskPattern(tt.input, tt.pattern)
+			if result != tt.expected {
+				t.Errorf("MaskPattern(%q, %q) = %q, want %q", tt.input, tt.pattern, result, tt.expected)
+			}
+		})
+	}
+}
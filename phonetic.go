@@ -0,0 +1,87 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// natoAlphabet holds the NATO/ICAO code words for A to Z, in ICAO spelling
+var natoAlphabet = []string{
+	"Alfa", "Bravo", "Charlie", "Delta", "Echo", "Foxtrot", "Golf", "Hotel",
+	"India", "Juliett", "Kilo", "Lima", "Mike", "November", "Oscar", "Papa",
+	"Quebec", "Romeo", "Sierra", "Tango", "Uniform", "Victor", "Whiskey",
+	"X-ray", "Yankee", "Zulu",
+}
+
+// phoneticSymbols holds the spoken names of common symbols in codes
+var phoneticSymbols = map[rune]string{
+	'-': "Dash", '.': "Dot", '_': "Underscore", '/': "Slash", '@': "At", ' ': "Space",
+}
+
+// phoneticLetters maps lowercase code words, including common alternative
+// spellings, back to the characters they spell
+var phoneticLetters = func() map[string]rune {
+	letters := map[string]rune{"alpha": 'A', "juliet": 'J', "xray": 'X', "niner": '9'}
+	for i, word := range natoAlphabet {
+		letters[strings.ToLower(word)] = rune('A' + i)
+	}
+	for d := range 10 {
+		letters[numberWords(int64(d))] = rune('0' + d)
+	}
+	for r, word := range phoneticSymbols {
+		letters[strings.ToLower(word)] = r
+	}
+	return letters
+}()
+
+// SpellPhonetic spells s using the NATO phonetic alphabet, for reading codes
+// aloud. Digits are spelled as English number words and common symbols by
+// name ("Dash", "Dot"); other characters are kept as they are. Words are
+// separated by spaces.
+//
+// Example:
+//
+//	SpellPhonetic("abc1") // "Alfa Bravo Charlie One"
+func SpellPhonetic(s string) string {
+	var words []string
+	for _, r := range s {
+		switch u := unicode.ToUpper(r); {
+		case u >= 'A' && u <= 'Z':
+			words = append(words, natoAlphabet[u-'A'])
+		case r >= '0' && r <= '9':
+			words = append(words, capitalizeWord(numberWords(int64(r-'0'))))
+		case phoneticSymbols[r] != "":
+			words = append(words, phoneticSymbols[r])
+		default:
+			words = append(words, string(r))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// ParsePhonetic reverses SpellPhonetic, turning space-separated code words
+// into the characters they spell. Matching is case-insensitive and accepts
+// the common spellings "Alpha", "Juliet", "Xray" and "Niner". Letters are
+// returned uppercase. Unknown words result in a *SyntaxError.
+//
+// Example:
+//
+//	ParsePhonetic("alpha bravo charlie one") // "ABC1", nil
+func ParsePhonetic(s string) (string, error) {
+	var b strings.Builder
+	offset := 0
+	for _, word := range strings.Split(s, " ") {
+		if word != "" {
+			r, ok := phoneticLetters[strings.ToLower(word)]
+			if !ok {
+				return "", &SyntaxError{Msg: fmt.Sprintf("unknown phonetic word %q", word), Offset: offset}
+			}
+			b.WriteRune(r)
+		}
+		offset += len(word) + 1
+	}
+	return b.String(), nil
+}
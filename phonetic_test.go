@@ -0,0 +1,73 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSpellPhonetic(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "abc1", expected: "Alfa Bravo Charlie One"},
+		{input: "XJ-9", expected: "X-ray Juliett Dash Nine"},
+		{input: "a.b_0", expected: "Alfa Dot Bravo Underscore Zero"},
+		{input: "a#", expected: "Alfa #"},
+		{input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := sx.SpellPhonetic(tt.input)
+			if result != tt.expected {
+				t.Errorf("SpellPhonetic(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParsePhonetic(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{name: "basic", input: "Alfa Bravo Charlie One", expected: "ABC1"},
+		{name: "alternative spellings", input: "alpha juliet XRAY niner", expected: "AJX9"},
+		{name: "symbols", input: "Kilo Dash Two Space Zulu", expected: "K-2 Z"},
+		{name: "extra spaces", input: " Echo  Echo ", expected: "EE"},
+		{name: "hyphenated x-ray", input: "X-ray", expected: "X"},
+		{name: "unknown", input: "Alfa Banana", expectErr: true},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.ParsePhonetic(tt.input)
+			if tt.expectErr {
+				var syntaxErr *sx.SyntaxError
+				if !errors.As(err, &syntaxErr) || syntaxErr.Offset != 5 {
+					t.Errorf("ParsePhonetic(%q) error = %v, want *SyntaxError at offset 5", tt.input, err)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("ParsePhonetic(%q) = %q, %v, want %q", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPhonetic_RoundTrip(t *testing.T) {
+	input := "AB12-XY.Z9"
+	result, err := sx.ParsePhonetic(sx.SpellPhonetic(input))
+	if err != nil || result != input {
+		t.Errorf("ParsePhonetic(SpellPhonetic(%q)) = %q, %v", input, result, err)
+	}
+}
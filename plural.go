@@ -0,0 +1,379 @@
+//go:build !sx_ascii
+
+package sx
+
+// Plural is a CLDR plural category
+type Plural int
+
+const (
+	// PluralOther is the general category, used by every language
+	PluralOther Plural = iota
+	// PluralZero is used for zero in languages such as Arabic, Latvian and Welsh
+	PluralZero
+	// PluralOne is used for singular forms
+	PluralOne
+	// PluralTwo is used for dual forms
+	PluralTwo
+	// PluralFew is used for paucal forms, such as Russian 2-4
+	PluralFew
+	// PluralMany is used for larger quantities, such as Russian 5-20
+	PluralMany
+)
+
+// pluralOrder is the canonical CLDR order of the categories
+var pluralOrder = []Plural{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther}
+
+// String returns the CLDR name of the category
+func (p Plural) String() string {
+	switch p {
+	case PluralZero:
+		return "zero"
+	case PluralOne:
+		return "one"
+	case PluralTwo:
+		return "two"
+	case PluralFew:
+		return "few"
+	case PluralMany:
+		return "many"
+	default:
+		return "other"
+	}
+}
+
+// pluralRule selects the plural category of a non-negative integer
+type pluralRule struct {
+	categories []Plural
+	category   func(n int) Plural
+}
+
+// inRange reports whether lo <= n <= hi
+func inRange(n, lo, hi int) bool {
+	return n >= lo && n <= hi
+}
+
+// Plural rules from the CLDR plural rules chart, restricted to integers.
+// Categories that only apply to fractions, such as Russian "other", are omitted.
+var (
+	pluralNone = &pluralRule{
+		categories: []Plural{PluralOther},
+		category:   func(int) Plural { return PluralOther },
+	}
+	pluralOneOther = &pluralRule{
+		categories: []Plural{PluralOne, PluralOther},
+		category: func(n int) Plural {
+			if n == 1 {
+				return PluralOne
+			}
+			return PluralOther
+		},
+	}
+	pluralZeroOneOther = &pluralRule{
+		categories: []Plural{PluralOne, PluralOther},
+		category: func(n int) Plural {
+			if n <= 1 {
+				return PluralOne
+			}
+			return PluralOther
+		},
+	}
+	// Romance languages use "many" for exact multiples of a million
+	pluralRomance = &pluralRule{
+		categories: []Plural{PluralOne, PluralMany, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n == 1:
+				return PluralOne
+			case n != 0 && n%1000000 == 0:
+				return PluralMany
+			}
+			return PluralOther
+		},
+	}
+	pluralFrench = &pluralRule{
+		categories: []Plural{PluralOne, PluralMany, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n <= 1:
+				return PluralOne
+			case n%1000000 == 0:
+				return PluralMany
+			}
+			return PluralOther
+		},
+	}
+	pluralEastSlavic = &pluralRule{
+		categories: []Plural{PluralOne, PluralFew, PluralMany},
+		category: func(n int) Plural {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return PluralOne
+			case inRange(n%10, 2, 4) && !inRange(n%100, 12, 14):
+				return PluralFew
+			}
+			return PluralMany
+		},
+	}
+	pluralPolish = &pluralRule{
+		categories: []Plural{PluralOne, PluralFew, PluralMany},
+		category: func(n int) Plural {
+			switch {
+			case n == 1:
+				return PluralOne
+			case inRange(n%10, 2, 4) && !inRange(n%100, 12, 14):
+				return PluralFew
+			}
+			return PluralMany
+		},
+	}
+	pluralWestSlavic = &pluralRule{
+		categories: []Plural{PluralOne, PluralFew, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n == 1:
+				return PluralOne
+			case inRange(n, 2, 4):
+				return PluralFew
+			}
+			return PluralOther
+		},
+	}
+	pluralSouthSlavic = &pluralRule{
+		categories: []Plural{PluralOne, PluralFew, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return PluralOne
+			case inRange(n%10, 2, 4) && !inRange(n%100, 12, 14):
+				return PluralFew
+			}
+			return PluralOther
+		},
+	}
+	pluralSlovenian = &pluralRule{
+		categories: []Plural{PluralOne, PluralTwo, PluralFew, PluralOther},
+		category: func(n int) Plural {
+			switch n % 100 {
+			case 1:
+				return PluralOne
+			case 2:
+				return PluralTwo
+			case 3, 4:
+				return PluralFew
+			}
+			return PluralOther
+		},
+	}
+	pluralLithuanian = &pluralRule{
+		categories: []Plural{PluralOne, PluralFew, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case inRange(n%100, 11, 19):
+				return PluralOther
+			case n%10 == 1:
+				return PluralOne
+			case n%10 >= 2:
+				return PluralFew
+			}
+			return PluralOther
+		},
+	}
+	pluralLatvian = &pluralRule{
+		categories: []Plural{PluralZero, PluralOne, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n%10 == 0 || inRange(n%100, 11, 19):
+				return PluralZero
+			case n%10 == 1:
+				return PluralOne
+			}
+			return PluralOther
+		},
+	}
+	pluralRomanian = &pluralRule{
+		categories: []Plural{PluralOne, PluralFew, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n == 1:
+				return PluralOne
+			case n == 0 || inRange(n%100, 1, 19):
+				return PluralFew
+			}
+			return PluralOther
+		},
+	}
+	pluralHebrew = &pluralRule{
+		categories: []Plural{PluralOne, PluralTwo, PluralOther},
+		category: func(n int) Plural {
+			switch n {
+			case 1:
+				return PluralOne
+			case 2:
+				return PluralTwo
+			}
+			return PluralOther
+		},
+	}
+	pluralArabic = &pluralRule{
+		categories: []Plural{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n == 0:
+				return PluralZero
+			case n == 1:
+				return PluralOne
+			case n == 2:
+				return PluralTwo
+			case inRange(n%100, 3, 10):
+				return PluralFew
+			case inRange(n%100, 11, 99):
+				return PluralMany
+			}
+			return PluralOther
+		},
+	}
+	pluralIrish = &pluralRule{
+		categories: []Plural{PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther},
+		category: func(n int) Plural {
+			switch {
+			case n == 1:
+				return PluralOne
+			case n == 2:
+				return PluralTwo
+			case inRange(n, 3, 6):
+				return PluralFew
+			case inRange(n, 7, 10):
+				return PluralMany
+			}
+			return PluralOther
+		},
+	}
+	pluralWelsh = &pluralRule{
+		categories: []Plural{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther},
+		category: func(n int) Plural {
+			switch n {
+			case 0:
+				return PluralZero
+			case 1:
+				return PluralOne
+			case 2:
+				return PluralTwo
+			case 3:
+				return PluralFew
+			case 6:
+				return PluralMany
+			}
+			return PluralOther
+		},
+	}
+	pluralIcelandic = &pluralRule{
+		categories: []Plural{PluralOne, PluralOther},
+		category: func(n int) Plural {
+			if n%10 == 1 && n%100 != 11 {
+				return PluralOne
+			}
+			return PluralOther
+		},
+	}
+)
+
+// pluralRules maps language and language-region tags to their plural rule.
+// Region-specific entries take precedence; languages not listed use the English rule.
+var pluralRules = map[string]*pluralRule{
+	"ja": pluralNone, "zh": pluralNone, "ko": pluralNone, "th": pluralNone,
+	"vi": pluralNone, "id": pluralNone, "ms": pluralNone, "lo": pluralNone,
+	"my": pluralNone, "km": pluralNone,
+
+	"en": pluralOneOther, "de": pluralOneOther, "nl": pluralOneOther, "sv": pluralOneOther,
+	"da": pluralOneOther, "no": pluralOneOther, "nb": pluralOneOther, "nn": pluralOneOther,
+	"fi": pluralOneOther, "et": pluralOneOther, "el": pluralOneOther, "hu": pluralOneOther,
+	"tr": pluralOneOther, "bg": pluralOneOther, "eu": pluralOneOther, "gl": pluralOneOther,
+	"sw": pluralOneOther, "ur": pluralOneOther, "af": pluralOneOther, "sq": pluralOneOther,
+	"az": pluralOneOther, "ka": pluralOneOther, "kk": pluralOneOther, "uz": pluralOneOther,
+	"mn": pluralOneOther, "ta": pluralOneOther, "te": pluralOneOther, "ml": pluralOneOther,
+
+	"hi": pluralZeroOneOther, "bn": pluralZeroOneOther, "fa": pluralZeroOneOther,
+	"gu": pluralZeroOneOther, "kn": pluralZeroOneOther, "am": pluralZeroOneOther,
+	"zu": pluralZeroOneOther,
+
+	"es": pluralRomance, "it": pluralRomance, "ca": pluralRomance,
+	"fr": pluralFrench, "pt": pluralFrench, "pt-pt": pluralRomance,
+
+	"ru": pluralEastSlavic, "uk": pluralEastSlavic, "be": pluralEastSlavic,
+	"pl": pluralPolish,
+	"cs": pluralWestSlavic, "sk": pluralWestSlavic,
+	"hr": pluralSouthSlavic, "sr": pluralSouthSlavic, "bs": pluralSouthSlavic,
+	"sl": pluralSlovenian,
+	"lt": pluralLithuanian,
+	"lv": pluralLatvian,
+	"ro": pluralRomanian,
+	"he": pluralHebrew,
+	"ar": pluralArabic,
+	"ga": pluralIrish,
+	"cy": pluralWelsh,
+	"is": pluralIcelandic, "mk": pluralIcelandic,
+}
+
+// lookupPluralRule returns the plural rule for a locale tag, falling back to English
+func lookupPluralRule(locale string) *pluralRule {
+	tag, lang := parseLocale(locale)
+	if rule, ok := pluralRules[tag]; ok {
+		return rule
+	}
+	if rule, ok := pluralRules[lang]; ok {
+		return rule
+	}
+	return pluralOneOther
+}
+
+// PluralCategory returns the CLDR plural category of the integer n in the
+// given language, such as "en", "ru" or "pt-BR". Unknown languages use the
+// English rule. Negative numbers are categorized by their absolute value.
+//
+// Example:
+//
+//	PluralCategory("ru", 3)  // PluralFew
+//	PluralCategory("ru", 11) // PluralMany
+//	PluralCategory("ar", 0)  // PluralZero
+func PluralCategory(lang string, n int) Plural {
+	if n < 0 {
+		n = -n
+	}
+	return lookupPluralRule(lang).category(n)
+}
+
+// PluralCategories returns the categories used by a language for integers,
+// in canonical CLDR order: zero, one, two, few, many, other
+func PluralCategories(lang string) []Plural {
+	rule := lookupPluralRule(lang)
+	categories := make([]Plural, 0, len(rule.categories))
+	for _, p := range pluralOrder {
+		for _, c := range rule.categories {
+			if c == p {
+				categories = append(categories, p)
+			}
+		}
+	}
+	return categories
+}
+
+// PluralizeN selects the form matching n from forms, which are given in the
+// order returned by PluralCategories for lang. The last form is used when
+// fewer forms than categories are supplied, and "" when none are.
+//
+// Example:
+//
+//	PluralizeN("en", 2, "file", "files")                 // "files"
+//	PluralizeN("ru", 5, "файл", "файла", "файлов") // "файлов"
+func PluralizeN(lang string, n int, forms ...string) string {
+	if len(forms) == 0 {
+		return ""
+	}
+	category := PluralCategory(lang, n)
+	for i, c := range PluralCategories(lang) {
+		if c == category && i < len(forms) {
+			return forms[i]
+		}
+	}
+	return forms[len(forms)-1]
+}
@@ -0,0 +1,126 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		lang     string
+		n        int
+		expected sx.Plural
+	}{
+		{lang: "en", n: 1, expected: sx.PluralOne},
+		{lang: "en", n: 0, expected: sx.PluralOther},
+		{lang: "en-US", n: 2, expected: sx.PluralOther},
+		{lang: "en", n: -1, expected: sx.PluralOne},
+		{lang: "xx", n: 1, expected: sx.PluralOne},
+		{lang: "ja", n: 1, expected: sx.PluralOther},
+		{lang: "fr", n: 0, expected: sx.PluralOne},
+		{lang: "fr", n: 2, expected: sx.PluralOther},
+		{lang: "fr", n: 1000000, expected: sx.PluralMany},
+		{lang: "pt_BR", n: 0, expected: sx.PluralOne},
+		{lang: "pt-PT", n: 0, expected: sx.PluralOther},
+		{lang: "es", n: 2000000, expected: sx.PluralMany},
+		{lang: "ru", n: 1, expected: sx.PluralOne},
+		{lang: "ru", n: 21, expected: sx.PluralOne},
+		{lang: "ru", n: 11, expected: sx.PluralMany},
+		{lang: "ru", n: 3, expected: sx.PluralFew},
+		{lang: "ru", n: 13, expected: sx.PluralMany},
+		{lang: "ru", n: 5, expected: sx.PluralMany},
+		{lang: "pl", n: 22, expected: sx.PluralFew},
+		{lang: "pl", n: 21, expected: sx.PluralMany},
+		{lang: "cs", n: 3, expected: sx.PluralFew},
+		{lang: "cs", n: 5, expected: sx.PluralOther},
+		{lang: "hr", n: 31, expected: sx.PluralOne},
+		{lang: "sl", n: 102, expected: sx.PluralTwo},
+		{lang: "lt", n: 15, expected: sx.PluralOther},
+		{lang: "lt", n: 23, expected: sx.PluralFew},
+		{lang: "lv", n: 10, expected: sx.PluralZero},
+		{lang: "ro", n: 101, expected: sx.PluralFew},
+		{lang: "ro", n: 20, expected: sx.PluralOther},
+		{lang: "ar", n: 0, expected: sx.PluralZero},
+		{lang: "ar", n: 2, expected: sx.PluralTwo},
+		{lang: "ar", n: 105, expected: sx.PluralFew},
+		{lang: "ar", n: 111, expected: sx.PluralMany},
+		{lang: "ar", n: 100, expected: sx.PluralOther},
+		{lang: "he", n: 2, expected: sx.PluralTwo},
+		{lang: "ga", n: 7, expected: sx.PluralMany},
+		{lang: "cy", n: 6, expected: sx.PluralMany},
+		{lang: "is", n: 21, expected: sx.PluralOne},
+		{lang: "hi", n: 0, expected: sx.PluralOne},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			result := sx.PluralCategory(tt.lang, tt.n)
+			if result != tt.expected {
+				t.Errorf("PluralCategory(%q, %d) = %v, want %v", tt.lang, tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPluralCategories(t *testing.T) {
+	tests := []struct {
+		lang     string
+		expected []sx.Plural
+	}{
+		{lang: "en", expected: []sx.Plural{sx.PluralOne, sx.PluralOther}},
+		{lang: "zh", expected: []sx.Plural{sx.PluralOther}},
+		{lang: "ru", expected: []sx.Plural{sx.PluralOne, sx.PluralFew, sx.PluralMany}},
+		{lang: "lv", expected: []sx.Plural{sx.PluralZero, sx.PluralOne, sx.PluralOther}},
+		{lang: "ar", expected: []sx.Plural{sx.PluralZero, sx.PluralOne, sx.PluralTwo, sx.PluralFew, sx.PluralMany, sx.PluralOther}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			result := sx.PluralCategories(tt.lang)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("PluralCategories(%q) = %v, want %v", tt.lang, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPluralizeN(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     string
+		n        int
+		forms    []string
+		expected string
+	}{
+		{name: "english singular", lang: "en", n: 1, forms: []string{"file", "files"}, expected: "file"},
+		{name: "english plural", lang: "en", n: 0, forms: []string{"file", "files"}, expected: "files"},
+		{name: "russian few", lang: "ru", n: 2, forms: []string{"файл", "файла", "файлов"}, expected: "файла"},
+		{name: "russian many", lang: "ru", n: 12, forms: []string{"файл", "файла", "файлов"}, expected: "файлов"},
+		{name: "missing forms use last", lang: "ru", n: 5, forms: []string{"файл", "файла"}, expected: "файла"},
+		{name: "no forms", lang: "en", n: 1, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.PluralizeN(tt.lang, tt.n, tt.forms...)
+			if result != tt.expected {
+				t.Errorf("PluralizeN(%q, %d, %q) = %q, want %q", tt.lang, tt.n, tt.forms, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPlural_String(t *testing.T) {
+	for plural, expected := range map[sx.Plural]string{
+		sx.PluralZero: "zero", sx.PluralOne: "one", sx.PluralTwo: "two",
+		sx.PluralFew: "few", sx.PluralMany: "many", sx.PluralOther: "other",
+	} {
+		if result := plural.String(); result != expected {
+			t.Errorf("Plural(%d).String() = %q, want %q", plural, result, expected)
+		}
+	}
+}
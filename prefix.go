@@ -0,0 +1,44 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// LongestPrefix returns the longest entry of prefixes that s starts with.
+// For matching many strings against the same set, use NewPrefixMatcher.
+func LongestPrefix(s string, prefixes []string) (string, bool) {
+	best, found := "", false
+	for _, p := range prefixes {
+		if (!found || len(p) > len(best)) && strings.HasPrefix(s, p) {
+			best, found = p, true
+		}
+	}
+	return best, found
+}
+
+// PrefixMatcher matches strings against a fixed set of prefixes.
+// Lookups cost O(len(s)) regardless of how many prefixes are registered.
+type PrefixMatcher struct {
+	trie *Trie[struct{}]
+}
+
+// NewPrefixMatcher compiles prefixes into a PrefixMatcher
+func NewPrefixMatcher(prefixes ...string) *PrefixMatcher {
+	m := &PrefixMatcher{trie: NewTrie[struct{}]()}
+	for _, p := range prefixes {
+		m.trie.Insert(p, struct{}{})
+	}
+	return m
+}
+
+// LongestPrefix returns the longest registered prefix that s starts with
+func (m *PrefixMatcher) LongestPrefix(s string) (string, bool) {
+	key, _, ok := m.trie.LongestPrefix(s)
+	return key, ok
+}
+
+// HasPrefix reports whether s starts with any registered prefix
+func (m *PrefixMatcher) HasPrefix(s string) bool {
+	_, ok := m.LongestPrefix(s)
+	return ok
+}
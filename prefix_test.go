@@ -0,0 +1,54 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestLongestPrefix(t *testing.T) {
+	prefixes := []string{"git", "git-", "git-lfs", "", "go"}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		found    bool
+	}{
+		{name: "longest wins", input: "git-lfs-pull", expected: "git-lfs", found: true},
+		{name: "middle", input: "git-commit", expected: "git-", found: true},
+		{name: "short", input: "github", expected: "git", found: true},
+		{name: "empty prefix matches anything", input: "rust", expected: "", found: true},
+		{name: "exact", input: "go", expected: "go", found: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := sx.LongestPrefix(tt.input, prefixes)
+			if got != tt.expected || ok != tt.found {
+				t.Errorf("LongestPrefix(%q) = %q, %v, want %q, %v", tt.input, got, ok, tt.expected, tt.found)
+			}
+
+			got, ok = sx.NewPrefixMatcher(prefixes...).LongestPrefix(tt.input)
+			if got != tt.expected || ok != tt.found {
+				t.Errorf("PrefixMatcher.LongestPrefix(%q) = %q, %v, want %q, %v", tt.input, got, ok, tt.expected, tt.found)
+			}
+		})
+	}
+}
+
+func TestPrefixMatcher_NoMatch(t *testing.T) {
+	m := sx.NewPrefixMatcher("/api/", "/static/")
+
+	if _, ok := m.LongestPrefix("/admin"); ok {
+		t.Errorf("LongestPrefix(%q) unexpectedly matched", "/admin")
+	}
+	if _, ok := sx.LongestPrefix("/admin", []string{"/api/", "/static/"}); ok {
+		t.Errorf("LongestPrefix(%q) unexpectedly matched", "/admin")
+	}
+	if !m.HasPrefix("/static/app.js") {
+		t.Errorf("HasPrefix(%q) = false, want true", "/static/app.js")
+	}
+}
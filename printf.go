@@ -0,0 +1,54 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"regexp"
+	"strings"
+)
+
+// printfVerbPattern matches a fmt directive: flags, optional argument index,
+// width and precision (possibly '*'), and the verb letter, or "%%"
+var printfVerbPattern = regexp.MustCompile(`%(?:%|[-+# 0]*(?:\[\d+\])?(?:\d+|\*)?(?:\.(?:\d+|\*)?)?(?:\[\d+\])?[a-zA-Z])`)
+
+// WithPrintfVerbs keeps fmt directives such as %d, %-10s, %.2f, %[1]v and
+// %% intact, so format strings can be converted without corrupting them.
+// It is shorthand for WithProtectedSpans with a pattern matching directives.
+//
+//	sx.SnakeCase("FetchedItems %d ForUser %q", sx.WithPrintfVerbs()) // fetched_items_%d_for_user_%q
+func WithPrintfVerbs() Option {
+	return WithProtectedSpans(printfVerbPattern)
+}
+
+// PrintfVerbs returns the fmt directives in format with their positions
+func PrintfVerbs(format string) []Match {
+	var matches []Match
+	for _, loc := range printfVerbPattern.FindAllStringIndex(format, -1) {
+		matches = append(matches, Match{Value: format[loc[0]:loc[1]], Start: loc[0], End: loc[1]})
+	}
+	return matches
+}
+
+// MapPrintfText applies fn to each run of literal text in format, leaving
+// fmt directives unchanged, so any string function can be used on format
+// strings. fn sees each run separately, so functions that trim whitespace
+// will also trim it around directives.
+//
+// Example:
+//
+//	MapPrintfText("Hello %s, %d%% left", strings.ToUpper) // "HELLO %s, %d%% LEFT"
+func MapPrintfText(format string, fn func(string) string) string {
+	var result strings.Builder
+	prev := 0
+	for _, verb := range PrintfVerbs(format) {
+		if verb.Start > prev {
+			result.WriteString(fn(format[prev:verb.Start]))
+		}
+		result.WriteString(verb.Value)
+		prev = verb.End
+	}
+	if prev < len(format) {
+		result.WriteString(fn(format[prev:]))
+	}
+	return result.String()
+}
@@ -0,0 +1,89 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWithPrintfVerbs(t *testing.T) {
+	tests := []struct {
+		name     string
+		function func(string) string
+		input    string
+		expected string
+	}{
+		{name: "snake", function: func(s string) string { return sx.SnakeCase(s, sx.WithPrintfVerbs()) }, input: "FetchedItems %d ForUser %q", expected: "fetched_items_%d_for_user_%q"},
+		{name: "kebab", function: func(s string) string { return sx.DelimitedCase(s, "-", sx.WithPrintfVerbs()) }, input: "Retry %-5s In %.2fSeconds", expected: "retry-%-5s-in-%.2f-seconds"},
+		{name: "camel", function: func(s string) string { return sx.CamelCase(s, sx.WithPrintfVerbs()) }, input: "user_%[1]v_name", expected: "user%[1]vName"},
+		{name: "percent literal", function: func(s string) string { return sx.SnakeCase(s, sx.WithPrintfVerbs()) }, input: "Progress %d%%", expected: "progress_%d_%%"},
+		{name: "uppercase verb", function: func(s string) string { return sx.SnakeCase(s, sx.WithPrintfVerbs()) }, input: "HexValue %X", expected: "hex_value_%X"},
+		{name: "without option", function: func(s string) string { return sx.SnakeCase(s) }, input: "Value %X", expected: "value_%x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.function(tt.input)
+			if result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPrintfVerbs(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "simple", input: "%s and %d", expected: []string{"%s", "%d"}},
+		{name: "flags width precision", input: "%-10s|%+.3f|%#x|%08b", expected: []string{"%-10s", "%+.3f", "%#x", "%08b"}},
+		{name: "star and index", input: "%*d %[2]*[1]d %.*s", expected: []string{"%*d", "%[2]*[1]d", "%.*s"}},
+		{name: "escaped percent", input: "100%% done", expected: []string{"%%"}},
+		{name: "trailing percent", input: "50%", expected: nil},
+		{name: "none", input: "plain text", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result []string
+			for _, m := range sx.PrintfVerbs(tt.input) {
+				if tt.input[m.Start:m.End] != m.Value {
+					t.Errorf("match %q does not match its position %d:%d", m.Value, m.Start, m.End)
+				}
+				result = append(result, m.Value)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("PrintfVerbs(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapPrintfText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		fn       func(string) string
+		expected string
+	}{
+		{name: "upper", input: "Hello %s, %d%% left", fn: strings.ToUpper, expected: "HELLO %s, %d%% LEFT"},
+		{name: "verbs only", input: "%s%d", fn: strings.ToUpper, expected: "%s%d"},
+		{name: "rot13", input: "user %v logged in", fn: sx.ROT13, expected: "hfre %v ybttrq va"},
+		{name: "empty", input: "", fn: strings.ToUpper, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.MapPrintfText(tt.input, tt.fn)
+			if result != tt.expected {
+				t.Errorf("MapPrintfText(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
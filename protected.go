@@ -0,0 +1,212 @@
+package sx
+
+import (
+	"cmp"
+	"slices"
+	"unicode/utf8"
+)
+
+// SpanMatcher finds regions of a string, returning their byte offsets like
+// regexp.Regexp.FindAllStringIndex, which *regexp.Regexp implements
+type SpanMatcher interface {
+	FindAllStringIndex(s string, n int) [][]int
+}
+
+// WithProtectedSpans keeps the regions of the input matching any of the
+// patterns verbatim: each becomes a single word that is neither split nor
+// re-cased, while the text around it is converted as usual.
+//
+//	sx.DelimitedCase("get:idById", "-", sx.WithProtectedSpans(regexp.MustCompile(`:\w+`))) // get-:id-by-id
+func WithProtectedSpans(patterns ...SpanMatcher) Option {
+	return func(c *Config) {
+		c.ProtectedSpans = append(c.ProtectedSpans, patterns...)
+	}
+}
+
+// WithProtectedDelimiters keeps regions enclosed in any of the delimiter
+// pairs, delimiters included, verbatim like WithProtectedSpans. Nested
+// delimiters are matched, so "{a{b}}" is protected as a whole.
+//
+//	sx.DelimitedCase("get{Resource}ById", "-", sx.WithProtectedDelimiters(sx.Pair{"{", "}"})) // get-{Resource}-by-id
+func WithProtectedDelimiters(pairs ...Pair) Option {
+	return func(c *Config) {
+		c.ProtectedDelimiters = append(c.ProtectedDelimiters, pairs...)
+	}
+}
+
+// WithProtectedWords keeps each of the words as a single word, whatever its
+// internal case and digit boundaries, rendered in the given spelling by every
+// converter. Words match case-insensitively where a word can start and end,
+// so "IPv6" is found in "ipv6_addr" and "setIPv6Addr" but not in "ipv64".
+//
+//	sx.SnakeCase("getOAuth2Token", sx.WithProtectedWords("OAuth2")) // get_OAuth2_token
+//	sx.PascalCase("grpc_client", sx.WithProtectedWords("gRPC"))     // gRPCClient
+func WithProtectedWords(words ...string) Option {
+	return func(c *Config) {
+		c.ProtectedWords = append(c.ProtectedWords, words...)
+	}
+}
+
+// appendWordSpans appends the byte ranges of the protected words found in s
+func (c *Config) appendWordSpans(spans [][2]int, s string) [][2]int {
+	for _, word := range c.ProtectedWords {
+		if word == "" {
+			continue
+		}
+		for i := 0; i+len(word) <= len(s); i++ {
+			end := i + len(word)
+			if utf8.RuneStart(s[i]) && equalFold(s[i:end], word) && isWordStart(s, i) && isWordEnd(s, end) {
+				spans = append(spans, [2]int{i, end})
+				i = end - 1
+			}
+		}
+	}
+	return spans
+}
+
+// isWordStart reports whether a word can start at s[i]: after a non
+// alphanumeric rune, or at an uppercase letter following a lowercase letter
+// or digit
+func isWordStart(s string, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+	first, _ := utf8.DecodeRuneInString(s[i:])
+	return !isLetterOrDigit(prev) || (isLower(prev) || isDigit(prev)) && isUpper(first)
+}
+
+// isWordEnd reports whether a word can end just before s[i]: before a non
+// alphanumeric rune, or before an uppercase letter that starts a new word
+func isWordEnd(s string, i int) bool {
+	if i == len(s) {
+		return true
+	}
+	last, _ := utf8.DecodeLastRuneInString(s[:i])
+	next, size := utf8.DecodeRuneInString(s[i:])
+	if !isLetterOrDigit(next) {
+		return true
+	}
+	if !isUpper(next) {
+		return false
+	}
+	// After an uppercase letter, only a capitalized word starts a new word: "gRPCService"
+	after, _ := utf8.DecodeRuneInString(s[i+size:])
+	return !isUpper(last) || isLower(after)
+}
+
+// protectedSpelling returns how the protected span text is rendered: in the
+// spelling of the protected word it matches, or verbatim
+func (c *Config) protectedSpelling(text string) string {
+	for _, word := range c.ProtectedWords {
+		if equalFold(word, text) {
+			return word
+		}
+	}
+	return text
+}
+
+// protectedSpans returns the sorted, non-overlapping byte ranges of s that are protected
+func (c *Config) protectedSpans(s string) [][2]int {
+	var spans [][2]int
+	for _, pattern := range c.ProtectedSpans {
+		for _, loc := range pattern.FindAllStringIndex(s, -1) {
+			if loc[0] < loc[1] {
+				spans = append(spans, [2]int{loc[0], loc[1]})
+			}
+		}
+	}
+	for _, pair := range c.ProtectedDelimiters {
+		for _, m := range ExtractAllBalanced(s, pair.Open, pair.Close) {
+			spans = append(spans, [2]int{m.Start - len(pair.Open), m.End + len(pair.Close)})
+		}
+	}
+	spans = c.appendWordSpans(spans, s)
+	if len(spans) == 0 {
+		return nil
+	}
+
+	// Earlier spans win; of two starting together, the longer one
+	slices.SortFunc(spans, func(a, b [2]int) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(b[1], a[1]))
+	})
+	kept := spans[:1]
+	for _, span := range spans[1:] {
+		if span[0] >= kept[len(kept)-1][1] {
+			kept = append(kept, span)
+		}
+	}
+	return kept
+}
+
+// split returns the words of s, see appendSplit
+func (c *Config) split(s string) []string {
+	words := c.appendSplit(nil, s)
+	if words == nil {
+		return []string{}
+	}
+	return words
+}
+
+// appendSplit appends the words of s to dst, splitting at the configured
+// separators and case changes after applying the UTF-8 policy. Protected
+// spans become single words, which are recorded so converters keep them
+// verbatim, and known affixes are isolated or stripped. In lossless mode the split is recorded as well.
+func (c *Config) appendSplit(dst []string, s string) []string {
+	n := len(dst)
+	clean := c.sanitize(s)
+	prefix, rest, suffix := c.splitAffixes(clean)
+	dst = c.appendAffix(dst, prefix)
+	dst = c.appendWords(dst, rest)
+	dst = c.appendAffix(dst, suffix)
+	if c.lossless != nil {
+		*c.lossless = newWordsInfo(s, clean, dst[n:])
+	}
+	return dst
+}
+
+// appendWords appends the words of s to dst, see appendSplit
+func (c *Config) appendWords(dst []string, s string) []string {
+	spans := c.protectedSpans(s)
+	if len(spans) == 0 {
+		return c.scanWords(dst, s)
+	}
+
+	c.protected = make(map[string]string, len(spans))
+	prev := 0
+	for _, span := range spans {
+		dst = c.appendBetweenSpans(dst, s[prev:span[0]], prev > 0)
+		dst = append(dst, s[span[0]:span[1]])
+		c.protected[s[span[0]:span[1]]] = c.protectedSpelling(s[span[0]:span[1]])
+		prev = span[1]
+	}
+	return c.appendBetweenSpans(dst, s[prev:], true)
+}
+
+// protectWords records the words that are protected as a whole, such as the
+// words of a protected span split earlier, so converters keep them verbatim
+func (c *Config) protectWords(words []string) {
+	if len(c.ProtectedSpans) == 0 && len(c.ProtectedDelimiters) == 0 && len(c.ProtectedWords) == 0 {
+		return
+	}
+	for _, word := range words {
+		if spans := c.protectedSpans(word); len(spans) == 1 && spans[0] == [2]int{0, len(word)} {
+			if c.protected == nil {
+				c.protected = make(map[string]string)
+			}
+			c.protected[word] = c.protectedSpelling(word)
+		}
+	}
+}
+
+// appendBetweenSpans appends the words of the text between protected spans.
+// A separator directly after a span only delimits it and does not produce an
+// empty word.
+func (c *Config) appendBetweenSpans(dst []string, s string, afterSpan bool) []string {
+	n := len(dst)
+	dst = c.scanWords(dst, s)
+	if afterSpan && len(dst) > n && dst[n] == "" {
+		dst = slices.Delete(dst, n, n+1)
+	}
+	return dst
+}
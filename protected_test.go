@@ -0,0 +1,109 @@
+package sx_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWithProtectedSpans(t *testing.T) {
+	braces := sx.WithProtectedDelimiters(sx.Pair{Open: "{", Close: "}"})
+	params := sx.WithProtectedSpans(regexp.MustCompile(`:\w+`))
+	verbs := sx.WithProtectedSpans(regexp.MustCompile(`%[sdv]`))
+
+	tests := []struct {
+		name     string
+		function func(string) string
+		input    string
+		expected string
+	}{
+		{name: "kebab placeholder", function: func(s string) string { return sx.DelimitedCase(s, "-", braces) }, input: "get{Resource}ById", expected: "get-{Resource}-by-id"},
+		{name: "snake placeholder", function: func(s string) string { return sx.SnakeCase(s, braces) }, input: "get{Resource}ById", expected: "get_{Resource}_by_id"},
+		{name: "flat placeholder", function: func(s string) string { return sx.FlatCase(s, braces) }, input: "get_{Resource}_by_id", expected: "get{Resource}byid"},
+		{name: "camel placeholder", function: func(s string) string { return sx.CamelCase(s, braces) }, input: "get_{resource_id}_by_id", expected: "get{resource_id}ById"},
+		{name: "pascal placeholder", function: func(s string) string { return sx.PascalCase(s, braces) }, input: "get-{id}-by-id", expected: "Get{id}ById"},
+		{name: "train placeholder", function: func(s string) string { return sx.TrainCase(s, braces) }, input: "get_{id}", expected: "Get-{id}"},
+		{name: "leading placeholder", function: func(s string) string { return sx.CamelCase(s, braces) }, input: "{Prefix}_user_name", expected: "{Prefix}UserName"},
+		{name: "nested delimiters", function: func(s string) string { return sx.SnakeCase(s, braces) }, input: "aB{x{Y}}cD", expected: "a_b_{x{Y}}_c_d"},
+		{name: "separators around span", function: func(s string) string { return sx.SnakeCase(s, braces) }, input: "user-{ID}-name", expected: "user_{ID}_name"},
+		{name: "regexp spans", function: func(s string) string { return sx.SnakeCase(s, params) }, input: "users/:userId/posts", expected: "users_:userId_posts"},
+		{name: "format verbs", function: func(s string) string { return sx.SnakeCase(s, verbs) }, input: "FetchedItems %d FromUser %s", expected: "fetched_items_%d_from_user_%s"},
+		{name: "no spans", function: func(s string) string { return sx.SnakeCase(s, braces) }, input: "fooBar", expected: "foo_bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.function(tt.input)
+			if result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWithProtectedWords(t *testing.T) {
+	words := sx.WithProtectedWords("OAuth2", "IPv6", "gRPC")
+
+	tests := []struct {
+		name     string
+		function func(string) string
+		input    string
+		expected string
+	}{
+		{name: "snake camel input", function: func(s string) string { return sx.SnakeCase(s, words) }, input: "getOAuth2Token", expected: "get_OAuth2_token"},
+		{name: "snake lower input", function: func(s string) string { return sx.SnakeCase(s, words) }, input: "ipv6_addr", expected: "IPv6_addr"},
+		{name: "camel", function: func(s string) string { return sx.CamelCase(s, words) }, input: "set_ipv6_addr", expected: "setIPv6Addr"},
+		{name: "pascal", function: func(s string) string { return sx.PascalCase(s, words) }, input: "grpc_client", expected: "gRPCClient"},
+		{name: "kebab", function: func(s string) string { return sx.DelimitedCase(s, "-", words) }, input: "gRPCService", expected: "gRPC-service"},
+		{name: "train", function: func(s string) string { return sx.TrainCase(s, words) }, input: "oauth2-login", expected: "OAuth2-Login"},
+		{name: "flat", function: func(s string) string { return sx.FlatCase(s, words) }, input: "use_OAUTH2", expected: "useOAuth2"},
+		{name: "longer word not matched", function: func(s string) string { return sx.SnakeCase(s, words) }, input: "ipv64_addr", expected: "ipv64_addr"},
+		{name: "inside word not matched", function: func(s string) string { return sx.SnakeCase(s, words) }, input: "xgrpc", expected: "xgrpc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.function(tt.input)
+			if result != tt.expected {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitByCaseProtectedWords(t *testing.T) {
+	result := sx.SplitByCase("getOAuth2TokenForIPv6", sx.WithProtectedWords("OAuth2", "IPv6"))
+	expected := []string{"get", "OAuth2", "Token", "For", "IPv6"}
+	if len(result) != len(expected) {
+		t.Fatalf("SplitByCase = %q, want %q", result, expected)
+	}
+	for i := range result {
+		if result[i] != expected[i] {
+			t.Fatalf("SplitByCase = %q, want %q", result, expected)
+		}
+	}
+}
+
+func TestDelimitedCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		separator string
+		expected  string
+	}{
+		{name: "dot", input: "fooBar", separator: ".", expected: "foo.bar"},
+		{name: "path", input: "HTTPServerConfig", separator: "/", expected: "http/server/config"},
+		{name: "matches kebab", input: "foo_bar-baz", separator: "-", expected: sx.KebabCase("foo_bar-baz")},
+		{name: "empty", input: "", separator: ".", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.DelimitedCase(tt.input, tt.separator)
+			if result != tt.expected {
+				t.Errorf("DelimitedCase(%q, %q) = %q, want %q", tt.input, tt.separator, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+//go:build !sx_ascii
+
+package sx
+
+import "math/rand/v2"
+
+// seededRand is a deterministic random source whose output depends only on
+// its seed. It uses the PCG generator, whose sequence is fixed by its
+// specification, and its own reduction to a range rather than the helpers of
+// math/rand/v2, so results stay stable across Go releases and platforms.
+type seededRand struct {
+	src *rand.PCG
+}
+
+// newSeededRand returns a seededRand for seed
+func newSeededRand(seed uint64) *seededRand {
+	return &seededRand{src: rand.NewPCG(seed, seed^0x9E3779B97F4A7C15)}
+}
+
+// intN returns a uniformly distributed integer in [0, n), rejecting values
+// from the incomplete top range to avoid modulo bias
+func (r *seededRand) intN(n int) int {
+	limit := ^uint64(0) - ^uint64(0)%uint64(n)
+	for {
+		if v := r.src.Uint64(); v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+// between returns a uniformly distributed integer in [lo, hi]
+func (r *seededRand) between(lo, hi int) int {
+	return lo + r.intN(hi-lo+1)
+}
+
+// RandomSeeded returns a pseudo-random string of n characters drawn uniformly
+// from charset. The output depends only on its arguments, so it is identical
+// across runs, platforms and Go releases, which makes it suitable for golden
+// tests; it must not be used for secrets. An empty charset uses URLAlphabet.
+// ErrInvalidAlphabet is returned unless the charset has between 2 and 256
+// distinct characters.
+//
+// Example:
+//
+//	s, _ := RandomSeeded(8, 42, "abc") // always the same 8 characters
+func RandomSeeded(n int, seed int64, charset string) (string, error) {
+	chars, err := alphabetRunes(charset)
+	if err != nil {
+		return "", err
+	}
+	if n <= 0 {
+		return "", nil
+	}
+
+	r := newSeededRand(uint64(seed))
+	out := make([]rune, n)
+	for i := range out {
+		out[i] = chars[r.intN(len(chars))]
+	}
+	return string(out), nil
+}
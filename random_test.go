@@ -0,0 +1,54 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestRandomSeeded(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		seed     int64
+		charset  string
+		expected string
+	}{
+		{name: "default charset", n: 16, seed: 0, charset: "", expected: "k3RVIJjafAN8_5M6"},
+		{name: "hex", n: 8, seed: 42, charset: "0123456789abcdef", expected: "0016a2a5"},
+		{name: "negative seed", n: 8, seed: -1, charset: "0123456789abcdef", expected: "e4e0893d"},
+		{name: "multibyte charset", n: 6, seed: 0, charset: "\u00e9\u00e8\u00ea", expected: "\u00e9\u00e9\u00e9\u00e8\u00ea\u00e8"},
+		{name: "zero length", n: 0, seed: 1, charset: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.RandomSeeded(tt.n, tt.seed, tt.charset)
+			if err != nil {
+				t.Fatalf("RandomSeeded(%d, %d, %q) error = %v", tt.n, tt.seed, tt.charset, err)
+			}
+			if result != tt.expected {
+				t.Errorf("RandomSeeded(%d, %d, %q) = %q, want %q", tt.n, tt.seed, tt.charset, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRandomSeededPrefix(t *testing.T) {
+	short, _ := sx.RandomSeeded(10, 7, "")
+	long, _ := sx.RandomSeeded(20, 7, "")
+	if long[:10] != short {
+		t.Errorf("RandomSeeded(20, 7) = %q does not extend RandomSeeded(10, 7) = %q", long, short)
+	}
+}
+
+func TestRandomSeededInvalidCharset(t *testing.T) {
+	for _, charset := range []string{"a", "aab"} {
+		if _, err := sx.RandomSeeded(4, 0, charset); !errors.Is(err, sx.ErrInvalidAlphabet) {
+			t.Errorf("RandomSeeded(4, 0, %q) error = %v, want ErrInvalidAlphabet", charset, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"io"
+
+	"golang.org/x/text/transform"
+)
+
+// defaultReaderBufSize is the initial size of the Reader buffers
+const defaultReaderBufSize = 4096
+
+// Reader streams the output of a Transformer applied to an underlying reader
+type Reader struct {
+	r   io.Reader
+	t   Transformer
+	err error
+
+	// src[src0:src1] is input read but not yet transformed
+	src        []byte
+	src0, src1 int
+	// dst[dst0:dst1] is output transformed but not yet returned
+	dst        []byte
+	dst0, dst1 int
+
+	transformComplete bool
+}
+
+// NewReader returns a Reader that applies t to the contents of r. Input is
+// buffered until t can process it, so a token is converted whole even when
+// it spans several reads. Unlike transform.NewReader, the buffers grow as
+// needed, so tokens longer than the initial buffer do not cause an error.
+//
+// Example:
+//
+//	r := NewReader(strings.NewReader("userId firstName"), SnakeCaseTransformer())
+//	io.ReadAll(r) // "user_id first_name"
+func NewReader(r io.Reader, t Transformer) *Reader {
+	t.Reset()
+	return &Reader{
+		r:   r,
+		t:   t,
+		src: make([]byte, defaultReaderBufSize),
+		dst: make([]byte, defaultReaderBufSize),
+	}
+}
+
+// Read implements io.Reader
+func (r *Reader) Read(p []byte) (int, error) {
+	for {
+		// Return transformed output first
+		if r.dst0 != r.dst1 {
+			n := copy(p, r.dst[r.dst0:r.dst1])
+			r.dst0 += n
+			if r.dst0 == r.dst1 && r.transformComplete {
+				return n, r.err
+			}
+			return n, nil
+		}
+		if r.transformComplete {
+			return 0, r.err
+		}
+
+		// Transform buffered input; with nothing buffered, read more first
+		if r.src0 != r.src1 || r.err != nil {
+			var n int
+			var err error
+			r.dst0 = 0
+			r.dst1, n, err = r.t.Transform(r.dst, r.src[r.src0:r.src1], r.err == io.EOF)
+			r.src0 += n
+
+			switch {
+			case err == nil:
+				if r.err != nil {
+					r.transformComplete = true
+				}
+				continue
+			case err == transform.ErrShortDst && (r.dst1 != 0 || n != 0):
+				continue
+			case err == transform.ErrShortDst:
+				r.dst = make([]byte, 2*len(r.dst))
+				continue
+			case err == transform.ErrShortSrc && r.err == nil:
+				if r.src1-r.src0 == len(r.src) {
+					r.src = append(r.src, make([]byte, len(r.src))...)
+				}
+			default:
+				r.transformComplete = true
+				if r.err == nil || r.err == io.EOF {
+					r.err = err
+				}
+				continue
+			}
+		}
+
+		// Move unconsumed input to the front of the buffer and read more
+		if r.src0 != 0 {
+			r.src0, r.src1 = 0, copy(r.src, r.src[r.src0:r.src1])
+		}
+		var n int
+		n, r.err = r.r.Read(r.src[r.src1:])
+		r.src1 += n
+	}
+}
@@ -0,0 +1,69 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gomantics/sx"
+)
+
+func TestNewReader(t *testing.T) {
+	longToken := strings.Repeat("ab", 5000) + "Cd"
+
+	tests := []struct {
+		name        string
+		transformer sx.Transformer
+		input       string
+		expected    string
+	}{
+		{name: "snake", transformer: sx.SnakeCaseTransformer(), input: "userId firstName\n", expected: "user_id first_name\n"},
+		{name: "camel", transformer: sx.CamelCaseTransformer(), input: "user_id\tlast_name", expected: "userId\tlastName"},
+		{name: "html", transformer: sx.StripHTMLTransformer(), input: "<p>Hello <b>world</b></p>", expected: "Hello world"},
+		{name: "token longer than buffer", transformer: sx.KebabCaseTransformer(), input: longToken + " x", expected: strings.ToLower(longToken[:len(longToken)-2]) + "-cd x"},
+		{name: "empty", transformer: sx.SnakeCaseTransformer(), input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readers := map[string]io.Reader{
+				"whole":    strings.NewReader(tt.input),
+				"one byte": iotest.OneByteReader(strings.NewReader(tt.input)),
+				"half":     iotest.HalfReader(strings.NewReader(tt.input)),
+			}
+			for name, r := range readers {
+				result, err := io.ReadAll(sx.NewReader(r, tt.transformer))
+				if err != nil {
+					t.Fatalf("%s: ReadAll error = %v", name, err)
+				}
+				if string(result) != tt.expected {
+					t.Errorf("%s: NewReader(%.20q) read %.40q, want %.40q", name, tt.input, result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewReader_SmallReads(t *testing.T) {
+	r := sx.NewReader(strings.NewReader("fooBar bazQux"), sx.SnakeCaseTransformer())
+	if err := iotest.TestReader(r, []byte("foo_bar baz_qux")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewReader_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	r := sx.NewReader(io.MultiReader(strings.NewReader("fooBar "), iotest.ErrReader(errBroken)), sx.SnakeCaseTransformer())
+
+	result, err := io.ReadAll(r)
+	if !errors.Is(err, errBroken) {
+		t.Errorf("ReadAll error = %v, want %v", err, errBroken)
+	}
+	if string(result) != "foo_bar " {
+		t.Errorf("ReadAll = %q, want %q", result, "foo_bar ")
+	}
+}
@@ -0,0 +1,54 @@
+//go:build !sx_ascii
+
+package sx
+
+import "strings"
+
+// Reflow joins hard-wrapped lines within each paragraph and wraps the result
+// to width display cells. Paragraphs are separated by blank lines, which are
+// preserved (collapsed to a single blank line). Words wider than width are
+// placed on their own line rather than broken.
+func Reflow(s string, width int) string {
+	var paragraphs []string
+	var current []string
+
+	flush := func() {
+		if len(current) > 0 {
+			paragraphs = append(paragraphs, wrapWords(current, width))
+			current = nil
+		}
+	}
+
+	for line := range strings.Lines(s) {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			flush()
+			continue
+		}
+		current = append(current, words...)
+	}
+	flush()
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// wrapWords greedily packs words into lines of at most width display cells
+func wrapWords(words []string, width int) string {
+	var result strings.Builder
+	lineWidth := 0
+	for i, word := range words {
+		w := DisplayWidth(word)
+		if i > 0 {
+			if lineWidth+1+w > width {
+				result.WriteByte('\n')
+				lineWidth = 0
+			} else {
+				result.WriteByte(' ')
+				lineWidth++
+			}
+		}
+		result.WriteString(word)
+		lineWidth += w
+	}
+	return result.String()
+}
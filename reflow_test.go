@@ -0,0 +1,70 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestReflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			name:     "joins hard-wrapped lines",
+			input:    "Fix the\nparser so\nit handles\nnesting.",
+			width:    20,
+			expected: "Fix the parser so it\nhandles nesting.",
+		},
+		{
+			name:     "preserves paragraph breaks",
+			input:    "Summary line\n\nFirst body\nparagraph.\n\n\nSecond one.",
+			width:    30,
+			expected: "Summary line\n\nFirst body paragraph.\n\nSecond one.",
+		},
+		{
+			name:     "narrower",
+			input:    "one two three four",
+			width:    9,
+			expected: "one two\nthree\nfour",
+		},
+		{
+			name:     "long word",
+			input:    "a supercalifragilistic b",
+			width:    5,
+			expected: "a\nsupercalifragilistic\nb",
+		},
+		{
+			name:     "wide characters",
+			input:    "日本 語 テスト",
+			width:    7,
+			expected: "日本 語\nテスト",
+		},
+		{
+			name:     "crlf and whitespace",
+			input:    "  a\r\n  b  \r\n\r\nc",
+			width:    10,
+			expected: "a b\n\nc",
+		},
+		{
+			name:     "empty",
+			input:    "",
+			width:    10,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.Reflow(tt.input, tt.width)
+			if result != tt.expected {
+				t.Errorf("Reflow(%q, %d) = %q, want %q", tt.input, tt.width, result, tt.expected)
+			}
+		})
+	}
+}
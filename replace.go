@@ -0,0 +1,56 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// hasPrefixFold reports whether s starts with prefix under Unicode case
+// folding, returning the number of bytes of s that matched
+func hasPrefixFold(s, prefix string) (int, bool) {
+	i := 0
+	for _, pr := range prefix {
+		if i >= len(s) {
+			return 0, false
+		}
+		sr, size := utf8.DecodeRuneInString(s[i:])
+		if sr != pr && foldRune(sr) != foldRune(pr) {
+			return 0, false
+		}
+		i += size
+	}
+	return i, true
+}
+
+// ReplaceAllFold replaces every non-overlapping occurrence of old in s with
+// new, matching case-insensitively, and returns the result along with the
+// number of replacements made. An empty old leaves s unchanged.
+func ReplaceAllFold(s, old, new string) (string, int) {
+	if old == "" {
+		return s, 0
+	}
+
+	var result strings.Builder
+	count, last := 0, 0
+	for i := 0; i < len(s); {
+		if n, ok := hasPrefixFold(s[i:], old); ok {
+			result.WriteString(s[last:i])
+			result.WriteString(new)
+			count++
+			i += n
+			last = i
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+
+	if count == 0 {
+		return s, 0
+	}
+
+	result.WriteString(s[last:])
+	return result.String(), count
+}
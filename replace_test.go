@@ -0,0 +1,38 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestReplaceAllFold(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		old      string
+		new      string
+		expected string
+		count    int
+	}{
+		{name: "mixed case", input: "Go go GO gO", old: "go", new: "Rust", expected: "Rust Rust Rust Rust", count: 4},
+		{name: "inside words", input: "FooBarfoo", old: "FOO", new: "x", expected: "xBarx", count: 2},
+		{name: "unicode", input: "STRASSE Straße", old: "straße", new: "road", expected: "STRASSE road", count: 1},
+		{name: "greek sigma", input: "ΣΊΣΥΦΟΣ σίσυφος", old: "σίσυφοσ", new: "x", expected: "x x", count: 2},
+		{name: "kelvin sign", input: "5\u212A", old: "k", new: "K", expected: "5K", count: 1},
+		{name: "no match", input: "hello", old: "world", new: "x", expected: "hello", count: 0},
+		{name: "empty old", input: "hello", old: "", new: "x", expected: "hello", count: 0},
+		{name: "delete", input: "a-B-a-b", old: "-b", new: "", expected: "a-a", count: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, count := sx.ReplaceAllFold(tt.input, tt.old, tt.new)
+			if result != tt.expected || count != tt.count {
+				t.Errorf("ReplaceAllFold(%q, %q, %q) = %q, %d, want %q, %d", tt.input, tt.old, tt.new, result, count, tt.expected, tt.count)
+			}
+		})
+	}
+}
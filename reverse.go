@@ -0,0 +1,54 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"slices"
+	"strings"
+)
+
+// ReverseOption configures ReverseWords
+type ReverseOption func(*ReverseConfig)
+
+// ReverseConfig holds the configuration for ReverseWords
+type ReverseConfig struct {
+	// SplitByCase splits on case changes and separators instead of whitespace
+	SplitByCase bool
+}
+
+// defaultReverseConfig returns the default configuration for ReverseWords
+func defaultReverseConfig() *ReverseConfig {
+	return &ReverseConfig{}
+}
+
+// WithCaseSplit makes ReverseWords split words the way SplitByCase does,
+// with the default options, so "fooBarBaz" becomes "Baz Bar foo"
+func WithCaseSplit() ReverseOption {
+	return func(c *ReverseConfig) {
+		c.SplitByCase = true
+	}
+}
+
+// ReverseWords reverses the order of the words in s while leaving each word
+// intact. Words are whitespace-separated by default and are joined with a
+// single space.
+func ReverseWords(s string, opts ...ReverseOption) string {
+	config := defaultReverseConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	var words []string
+	if config.SplitByCase {
+		for _, word := range emptyConfig.split(s) {
+			if word != "" {
+				words = append(words, word)
+			}
+		}
+	} else {
+		words = strings.Fields(s)
+	}
+
+	slices.Reverse(words)
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,35 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestReverseWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.ReverseOption
+		expected string
+	}{
+		{name: "simple", input: "one two three", expected: "three two one"},
+		{name: "extra whitespace", input: "  hello \t world\n", expected: "world hello"},
+		{name: "punctuation kept", input: "Hello, world!", expected: "world! Hello,"},
+		{name: "single word", input: "fooBar", expected: "fooBar"},
+		{name: "empty", input: "", expected: ""},
+		{name: "case split", input: "fooBarBaz", options: []sx.ReverseOption{sx.WithCaseSplit()}, expected: "Baz Bar foo"},
+		{name: "case split separators", input: "user__id-XMLParser", options: []sx.ReverseOption{sx.WithCaseSplit()}, expected: "Parser XML id user"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.ReverseWords(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("ReverseWords(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//go:build !sx_ascii
+
+package sx
+
+// Searcher finds a fixed needle in many haystacks. The needle is preprocessed
+// once (Boyer-Moore-Horspool) so each search only pays for the scan itself.
+type Searcher struct {
+	needle string
+	skip   [256]int
+}
+
+// NewSearcher preprocesses needle for repeated searching
+func NewSearcher(needle string) *Searcher {
+	s := &Searcher{needle: needle}
+
+	n := len(needle)
+	for i := range s.skip {
+		s.skip[i] = n
+	}
+	for i := 0; i < n-1; i++ {
+		s.skip[needle[i]] = n - 1 - i
+	}
+
+	return s
+}
+
+// Needle returns the pattern the searcher looks for
+func (s *Searcher) Needle() string {
+	return s.needle
+}
+
+// IndexIn returns the byte index of the first occurrence of the needle in
+// text, or -1 if it is not present
+func (s *Searcher) IndexIn(text string) int {
+	return s.indexFrom(text, 0)
+}
+
+// Contains reports whether the needle occurs in text
+func (s *Searcher) Contains(text string) bool {
+	return s.IndexIn(text) >= 0
+}
+
+// indexFrom returns the first match starting at or after byte offset from
+func (s *Searcher) indexFrom(text string, from int) int {
+	n := len(s.needle)
+	if n == 0 {
+		if from <= len(text) {
+			return from
+		}
+		return -1
+	}
+
+	last := s.needle[n-1]
+	for i := from; i+n <= len(text); {
+		c := text[i+n-1]
+		if c == last && text[i:i+n-1] == s.needle[:n-1] {
+			return i
+		}
+		i += s.skip[c]
+	}
+
+	return -1
+}
+
+// IndexAll returns the byte offsets of every occurrence of substr in s.
+// When overlap is true, matches may share bytes ("aa" occurs at 0, 1 and 2
+// in "aaaa"); otherwise scanning resumes after each match. An empty substr
+// yields no matches.
+func IndexAll(s, substr string, overlap bool) []int {
+	if substr == "" {
+		return nil
+	}
+
+	step := len(substr)
+	if overlap {
+		step = 1
+	}
+
+	searcher := NewSearcher(substr)
+	var offsets []int
+	for i := searcher.indexFrom(s, 0); i >= 0; i = searcher.indexFrom(s, i+step) {
+		offsets = append(offsets, i)
+	}
+
+	return offsets
+}
@@ -0,0 +1,71 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSearcher(t *testing.T) {
+	tests := []struct {
+		name   string
+		needle string
+		text   string
+	}{
+		{name: "found in middle", needle: "needle", text: "haystack with a needle in it"},
+		{name: "found at start", needle: "hay", text: "haystack"},
+		{name: "found at end", needle: "stack", text: "haystack"},
+		{name: "not found", needle: "pin", text: "haystack"},
+		{name: "repeated chars", needle: "aab", text: "aaaaaaab"},
+		{name: "single byte", needle: "k", text: "haystack"},
+		{name: "needle longer than text", needle: "haystacks", text: "haystack"},
+		{name: "empty needle", needle: "", text: "haystack"},
+		{name: "empty text", needle: "a", text: ""},
+		{name: "multibyte", needle: "世界", text: "hello, 世界!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := sx.NewSearcher(tt.needle)
+			expected := strings.Index(tt.text, tt.needle)
+			if got := s.IndexIn(tt.text); got != expected {
+				t.Errorf("IndexIn(%q) for %q = %d, want %d", tt.text, tt.needle, got, expected)
+			}
+			if got := s.Contains(tt.text); got != (expected >= 0) {
+				t.Errorf("Contains(%q) for %q = %v", tt.text, tt.needle, got)
+			}
+		})
+	}
+}
+
+func TestIndexAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		substr   string
+		overlap  bool
+		expected []int
+	}{
+		{name: "non-overlapping", input: "aaaa", substr: "aa", overlap: false, expected: []int{0, 2}},
+		{name: "overlapping", input: "aaaa", substr: "aa", overlap: true, expected: []int{0, 1, 2}},
+		{name: "words", input: "the cat and the hat", substr: "the", overlap: false, expected: []int{0, 12}},
+		{name: "overlapping pattern", input: "abababa", substr: "aba", overlap: true, expected: []int{0, 2, 4}},
+		{name: "non-overlapping pattern", input: "abababa", substr: "aba", overlap: false, expected: []int{0, 4}},
+		{name: "multibyte", input: "ünï ünï", substr: "ünï", overlap: true, expected: []int{0, 6}},
+		{name: "no match", input: "abc", substr: "d", overlap: true, expected: nil},
+		{name: "empty substr", input: "abc", substr: "", overlap: false, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.IndexAll(tt.input, tt.substr, tt.overlap)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("IndexAll(%q, %q, %v) = %v, want %v", tt.input, tt.substr, tt.overlap, result, tt.expected)
+			}
+		})
+	}
+}
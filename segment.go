@@ -0,0 +1,138 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	_ "embed"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed words_en.txt
+var englishWordList string
+
+// WordDict is a frequency-ranked word list used by SegmentWords
+type WordDict struct {
+	costs   map[string]float64
+	maxLen  int
+	maxCost float64
+}
+
+// NewWordDict builds a dictionary from words ordered from most to least
+// frequent. Words are matched case-insensitively; each word is given a cost
+// derived from its rank (Zipf's law), so segmentations using common words
+// are preferred. Duplicate words keep their first, most frequent rank.
+func NewWordDict(words []string) *WordDict {
+	d := &WordDict{costs: make(map[string]float64, len(words))}
+	logN := math.Log(float64(max(len(words), 2)))
+	for rank, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" {
+			continue
+		}
+		if _, ok := d.costs[word]; ok {
+			continue
+		}
+		d.costs[word] = math.Log(float64(rank+1) * logN)
+		d.maxLen = max(d.maxLen, len([]rune(word)))
+	}
+	d.maxCost = math.Log(float64(len(words)+1) * logN)
+	return d
+}
+
+// Contains reports whether word is in the dictionary, ignoring case
+func (d *WordDict) Contains(word string) bool {
+	_, ok := d.costs[strings.ToLower(word)]
+	return ok
+}
+
+// Len returns the number of distinct words in the dictionary
+func (d *WordDict) Len() int {
+	return len(d.costs)
+}
+
+// englishWords is the lazily parsed default dictionary
+var englishWords = sync.OnceValue(func() *WordDict {
+	return NewWordDict(strings.Fields(englishWordList))
+})
+
+// EnglishWords returns the built-in dictionary of common English words,
+// including common programming terms, used by SegmentWords by default
+func EnglishWords() *WordDict {
+	return englishWords()
+}
+
+// SegmentWords splits text written without separators into the most likely
+// sequence of dictionary words, using dynamic programming over word costs.
+// Runs of digits are kept together, and characters that cannot be covered by
+// dictionary words are grouped into single unknown words. The original
+// spelling of the input is preserved. A nil dict uses EnglishWords.
+//
+// Example:
+//
+//	SegmentWords("thisisatest", nil) // []string{"this", "is", "a", "test"}
+func SegmentWords(s string, dict *WordDict) []string {
+	if dict == nil {
+		dict = EnglishWords()
+	}
+
+	runes := []rune(s)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// best[i] is the cheapest cost of segmenting runes[:i]; from[i] is where
+	// its last word starts
+	n := len(runes)
+	best := make([]float64, n+1)
+	from := make([]int, n+1)
+	unknown := make([]bool, n+1)
+	for i := 1; i <= n; i++ {
+		best[i] = math.Inf(1)
+	}
+
+	unknownCost := 2 * dict.maxCost
+	for i := 0; i < n; i++ {
+		if math.IsInf(best[i], 1) {
+			continue
+		}
+		relax := func(j int, cost float64, isUnknown bool) {
+			if c := best[i] + cost; c < best[j] {
+				best[j], from[j], unknown[j] = c, i, isUnknown
+			}
+		}
+
+		if unicode.IsDigit(runes[i]) {
+			j := i + 1
+			for j < n && unicode.IsDigit(runes[j]) {
+				j++
+			}
+			relax(j, dict.maxCost, false)
+			continue
+		}
+
+		relax(i+1, unknownCost, true)
+		for j := i + 1; j <= min(n, i+dict.maxLen); j++ {
+			if cost, ok := dict.costs[string(lower[i:j])]; ok {
+				relax(j, cost, false)
+			}
+		}
+	}
+
+	// Walk back through the chosen words, merging adjacent unknown runes
+	var words []string
+	for end := n; end > 0; {
+		start := from[end]
+		for unknown[end] && start > 0 && unknown[start] {
+			start = from[start]
+		}
+		words = append(words, string(runes[start:end]))
+		end = start
+	}
+	slices.Reverse(words)
+	return words
+}
@@ -0,0 +1,64 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSegmentWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		dict     *sx.WordDict
+		expected []string
+	}{
+		{name: "sentence", input: "thisisatest", expected: []string{"this", "is", "a", "test"}},
+		{name: "identifier", input: "getuserbyid", expected: []string{"get", "user", "by", "id"}},
+		{name: "domain", input: "findmyphone", expected: []string{"find", "my", "phone"}},
+		{name: "case preserved", input: "HelloWorld", expected: []string{"Hello", "World"}},
+		{name: "digits kept together", input: "page404error", expected: []string{"page", "404", "error"}},
+		{name: "unknown run grouped", input: "helloxyzqworld", expected: []string{"hello", "xyzq", "world"}},
+		{name: "empty", input: "", expected: nil},
+		{
+			name:     "custom dictionary",
+			input:    "kubectlapply",
+			dict:     sx.NewWordDict([]string{"apply", "kubectl"}),
+			expected: []string{"kubectl", "apply"},
+		},
+		{
+			name:     "frequency decides",
+			input:    "nowhere",
+			dict:     sx.NewWordDict([]string{"now", "here", "nowhere", "no", "where"}),
+			expected: []string{"nowhere"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.SegmentWords(tt.input, tt.dict)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("SegmentWords(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWordDict(t *testing.T) {
+	dict := sx.NewWordDict([]string{"Alpha", "beta", "alpha", " "})
+	if dict.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", dict.Len())
+	}
+	if !dict.Contains("ALPHA") {
+		t.Error("Contains(\"ALPHA\") = false, want true")
+	}
+	if dict.Contains("gamma") {
+		t.Error("Contains(\"gamma\") = true, want false")
+	}
+	if !sx.EnglishWords().Contains("the") {
+		t.Error("EnglishWords().Contains(\"the\") = false, want true")
+	}
+}
@@ -0,0 +1,157 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// shoutedRatio is the share of uppercase letters above which a sentence is
+// treated as shouted
+const shoutedRatio = 0.7
+
+// NormalizeSentences fixes the casing of free text such as user-submitted
+// titles and descriptions. Sentences written mostly in uppercase are
+// lowercased, and the first letter of every sentence is capitalized.
+// All-uppercase words in otherwise normally cased sentences are kept, so
+// acronyms survive; acronyms registered with WithAcronyms are restored to
+// their registered spelling everywhere. The pronoun "I" is always capitalized.
+//
+// Example:
+//
+//	NormalizeSentences("GREAT PRODUCT!! works with NASA data. five stars")
+//	// "Great product!! Works with NASA data. Five stars"
+func NormalizeSentences(s string, opts ...Option) string {
+	config := newConfig(opts)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, sentence := range splitSentences(s) {
+		shouted := isShouted(sentence)
+		first := true
+		for i := 0; i < len(sentence); {
+			r, size := utf8.DecodeRuneInString(sentence[i:])
+			if !isLetterOrDigit(r) {
+				b.WriteRune(r)
+				i += size
+				continue
+			}
+
+			end := i + wordEnd(sentence[i:])
+			b.WriteString(normalizeSentenceWord(sentence[i:end], config, shouted, first))
+			first = false
+			i = end
+		}
+	}
+	return b.String()
+}
+
+// normalizeSentenceWord applies NormalizeSentences' rules to a single word
+func normalizeSentenceWord(word string, config *Config, shouted, first bool) string {
+	if registered, ok := config.acronym(word); ok {
+		return registered
+	}
+	if shouted && isUpperWord(word) {
+		word = strings.ToLower(word)
+	}
+	if first || word == "i" || strings.HasPrefix(word, "i'") || strings.HasPrefix(word, "i’") {
+		word = capitalizeWord(word)
+	}
+	return word
+}
+
+// wordEnd returns the byte length of the word at the start of s: letters and
+// digits, with inner apostrophes as in "don't"
+func wordEnd(s string) int {
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\'' || r == '’' {
+			next, _ := utf8.DecodeRuneInString(s[i+size:])
+			if !unicode.IsLetter(next) {
+				break
+			}
+		} else if !isLetterOrDigit(r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// isShouted reports whether most cased letters in s are uppercase
+func isShouted(s string) bool {
+	var upper, cased int
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			upper++
+			cased++
+		} else if unicode.IsLower(r) {
+			cased++
+		}
+	}
+	return upper > 1 && float64(upper) > shoutedRatio*float64(cased)
+}
+
+// splitSentences splits s after each run of sentence terminators followed by
+// whitespace, keeping terminators, closing quotes and whitespace with the
+// sentence they end. A period after a single letter, as in "e.g." or "U.S.",
+// is treated as an abbreviation rather than the end of a sentence.
+func splitSentences(s string) []string {
+	var sentences []string
+	start := 0
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isSentenceTerminator(r) || r == '.' && isAbbreviationDot(s[:i]) {
+			i += size
+			continue
+		}
+
+		// Consume further terminators and closing punctuation
+		j := i + size
+		for j < len(s) {
+			r, size := utf8.DecodeRuneInString(s[j:])
+			if !isSentenceTerminator(r) && !strings.ContainsRune(`"'”’)]`, r) {
+				break
+			}
+			j += size
+		}
+
+		// A sentence only ends if whitespace follows
+		k := j
+		for k < len(s) {
+			r, size := utf8.DecodeRuneInString(s[k:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			k += size
+		}
+		if k > j || k == len(s) {
+			sentences = append(sentences, s[start:k])
+			start = k
+		}
+		i = k
+	}
+	if start < len(s) {
+		sentences = append(sentences, s[start:])
+	}
+	return sentences
+}
+
+// isSentenceTerminator reports whether r ends a sentence
+func isSentenceTerminator(r rune) bool {
+	return r == '.' || r == '!' || r == '?' || r == '…'
+}
+
+// isAbbreviationDot reports whether a period following before is part of an
+// abbreviation: the preceding word is a single letter
+func isAbbreviationDot(before string) bool {
+	last, size := utf8.DecodeLastRuneInString(before)
+	if !unicode.IsLetter(last) {
+		return false
+	}
+	prev, _ := utf8.DecodeLastRuneInString(before[:len(before)-size])
+	return len(before) == size || !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
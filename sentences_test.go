@@ -0,0 +1,50 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestNormalizeSentences(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []sx.CaseOption
+		expected string
+	}{
+		{name: "shouted", input: "THIS IS BROKEN. PLEASE FIX IT!", expected: "This is broken. Please fix it!"},
+		{name: "capitalize sentences", input: "hello there. how are you? fine!", expected: "Hello there. How are you? Fine!"},
+		{name: "acronyms in normal text", input: "works with NASA data. uses the API", expected: "Works with NASA data. Uses the API"},
+		{
+			name:     "mixed",
+			input:    "GREAT PRODUCT!! works with NASA data. five stars",
+			expected: "Great product!! Works with NASA data. Five stars",
+		},
+		{
+			name:     "registered acronyms in shouted text",
+			input:    "THE API RETURNS JSON.",
+			options:  []sx.CaseOption{sx.WithAcronyms("API", "JSON")},
+			expected: "The API returns JSON.",
+		},
+		{name: "pronoun", input: "I THINK I'M RIGHT", expected: "I think I'm right"},
+		{name: "contractions", input: "DON'T STOP", expected: "Don't stop"},
+		{name: "abbreviation", input: "bring snacks, e.g. chips. and drinks", expected: "Bring snacks, e.g. chips. And drinks"},
+		{name: "decimal", input: "it costs 3.50 now", expected: "It costs 3.50 now"},
+		{name: "quotes", input: "she said \"stop.\" then left", expected: "She said \"stop.\" Then left"},
+		{name: "whitespace kept", input: "one.\n\ntwo", expected: "One.\n\nTwo"},
+		{name: "unicode", input: "\u00c9T\u00c9 CHAUD. tr\u00e8s", expected: "\u00c9t\u00e9 chaud. Tr\u00e8s"},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.NormalizeSentences(tt.input, tt.options...)
+			if result != tt.expected {
+				t.Errorf("NormalizeSentences(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
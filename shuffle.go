@@ -0,0 +1,38 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// Shuffle returns a random permutation of the runes of s drawn from r, so a
+// seeded source always yields the same output. This makes it handy for
+// generating property-test inputs. A nil r uses the global source.
+func Shuffle(s string, r *rand.Rand) string {
+	runes := []rune(s)
+	shuffle(r, len(runes), func(i, j int) {
+		runes[i], runes[j] = runes[j], runes[i]
+	})
+	return string(runes)
+}
+
+// ShuffleGraphemes is like Shuffle but permutes grapheme clusters, so
+// combining marks and emoji sequences stay attached to their base characters
+func ShuffleGraphemes(s string, r *rand.Rand) string {
+	graphemes := splitGraphemes(s)
+	shuffle(r, len(graphemes), func(i, j int) {
+		graphemes[i], graphemes[j] = graphemes[j], graphemes[i]
+	})
+	return strings.Join(graphemes, "")
+}
+
+// shuffle permutes n elements using r, or the global source if r is nil
+func shuffle(r *rand.Rand, n int, swap func(i, j int)) {
+	if r == nil {
+		rand.Shuffle(n, swap)
+		return
+	}
+	r.Shuffle(n, swap)
+}
@@ -0,0 +1,63 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func sortedRunes(s string) string {
+	runes := []rune(s)
+	slices.Sort(runes)
+	return string(runes)
+}
+
+func TestShuffle(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "ascii", input: "hello world"},
+		{name: "unicode", input: "日本語テキスト"},
+		{name: "single", input: "a"},
+		{name: "empty", input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := sx.Shuffle(tt.input, rand.New(rand.NewPCG(1, 2)))
+			second := sx.Shuffle(tt.input, rand.New(rand.NewPCG(1, 2)))
+			if first != second {
+				t.Errorf("Shuffle(%q) not deterministic: %q vs %q", tt.input, first, second)
+			}
+			if sortedRunes(first) != sortedRunes(tt.input) {
+				t.Errorf("Shuffle(%q) = %q, not a permutation", tt.input, first)
+			}
+		})
+	}
+}
+
+func TestShuffle_NilSource(t *testing.T) {
+	input := "abcdef"
+	if result := sx.Shuffle(input, nil); sortedRunes(result) != input {
+		t.Errorf("Shuffle(%q, nil) = %q, not a permutation", input, result)
+	}
+}
+
+func TestShuffleGraphemes(t *testing.T) {
+	input := "éáó"
+	result := sx.ShuffleGraphemes(input, rand.New(rand.NewPCG(3, 4)))
+	if len(result) != len(input) {
+		t.Fatalf("ShuffleGraphemes(%q) = %q, length changed", input, result)
+	}
+	for _, cluster := range []string{"é", "á", "ó"} {
+		if !strings.Contains(result, cluster) {
+			t.Errorf("ShuffleGraphemes(%q) = %q, cluster %q broken", input, result, cluster)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// sortableIDLen is the length of a SortableID: 128 bits in 5-bit characters
+const sortableIDLen = 26
+
+// SortableID returns a new ULID: a 26-character Crockford base32 string made
+// of a 48-bit millisecond timestamp followed by 80 random bits from
+// crypto/rand. IDs sort lexicographically by creation time; IDs created within
+// the same millisecond are in random order.
+func SortableID() string {
+	return SortableIDAt(time.Now())
+}
+
+// SortableIDAt is like SortableID but uses t as the timestamp, for example
+// when backfilling IDs for existing records
+func SortableIDAt(t time.Time) string {
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], uint64(t.UnixMilli())<<16)
+	rand.Read(id[6:])
+
+	// Encode the 128 bits most significant first, with two leading zero bits
+	hi, lo := binary.BigEndian.Uint64(id[:8]), binary.BigEndian.Uint64(id[8:])
+	var out [sortableIDLen]byte
+	for i := sortableIDLen - 1; i >= 0; i-- {
+		out[i] = crockfordAlphabet[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:])
+}
+
+// SortableIDTime returns the timestamp embedded in a SortableID, with
+// millisecond precision. Decoding is case-insensitive.
+func SortableIDTime(id string) (time.Time, error) {
+	if len(id) != sortableIDLen {
+		return time.Time{}, &SyntaxError{Msg: fmt.Sprintf("invalid sortable id length %d", len(id)), Offset: 0}
+	}
+
+	var ms uint64
+	for i := 0; i < sortableIDLen; i++ {
+		v := strings.IndexByte(crockfordAlphabet, upperASCII(id[i]))
+		if v < 0 || i == 0 && v > 7 {
+			return time.Time{}, &SyntaxError{Msg: fmt.Sprintf("invalid sortable id character %q", id[i]), Offset: i}
+		}
+		if i < 10 {
+			ms = ms<<5 | uint64(v)
+		}
+	}
+	return time.UnixMilli(int64(ms)), nil
+}
+
+// upperASCII uppercases an ASCII letter
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
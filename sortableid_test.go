@@ -0,0 +1,63 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSortableIDAt(t *testing.T) {
+	// Timestamp prefix from the ULID specification
+	ts := time.UnixMilli(1469918176385)
+	id := sx.SortableIDAt(ts)
+	if len(id) != 26 {
+		t.Fatalf("SortableIDAt() = %q, want 26 characters", id)
+	}
+	if !strings.HasPrefix(id, "01ARYZ6S41") {
+		t.Errorf("SortableIDAt() = %q, want prefix %q", id, "01ARYZ6S41")
+	}
+
+	parsed, err := sx.SortableIDTime(strings.ToLower(id))
+	if err != nil || !parsed.Equal(ts) {
+		t.Errorf("SortableIDTime(%q) = %v, %v, want %v", id, parsed, err, ts)
+	}
+}
+
+func TestSortableID_Order(t *testing.T) {
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	prev := sx.SortableIDAt(base)
+	for i := 1; i <= 100; i++ {
+		next := sx.SortableIDAt(base.Add(time.Duration(i) * time.Millisecond))
+		if next <= prev {
+			t.Fatalf("SortableIDAt not increasing: %q <= %q", next, prev)
+		}
+		prev = next
+	}
+}
+
+func TestSortableID_Unique(t *testing.T) {
+	seen := make(map[string]bool)
+	for range 1000 {
+		id := sx.SortableID()
+		if seen[id] {
+			t.Fatalf("SortableID() returned duplicate %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestSortableIDTime_Invalid(t *testing.T) {
+	for _, id := range []string{"", "01ARYZ6S41", "81ARYZ6S41TSV4RRFFQ69G5FAV", "01ARYZ6S41TSV4RRFFQ69G5FAU"} {
+		t.Run(id, func(t *testing.T) {
+			var syntaxErr *sx.SyntaxError
+			if _, err := sx.SortableIDTime(id); !errors.As(err, &syntaxErr) {
+				t.Errorf("SortableIDTime(%q) error = %v, want *SyntaxError", id, err)
+			}
+		})
+	}
+}
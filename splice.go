@@ -0,0 +1,115 @@
+//go:build !sx_ascii
+
+package sx
+
+import "unicode/utf8"
+
+// SpliceOption configures Splice, SpliceGraphemes and Overlay
+type SpliceOption func(*SpliceConfig)
+
+// SpliceConfig holds the configuration for Splice, SpliceGraphemes and Overlay
+type SpliceConfig struct {
+	// FromEnd makes negative indices count from the end, so -1 is the last
+	// rune or grapheme cluster
+	FromEnd bool
+	// Strict leaves the string unchanged when an index is out of range,
+	// instead of clamping it to the string
+	Strict bool
+}
+
+// defaultSpliceConfig returns the default configuration
+func defaultSpliceConfig() *SpliceConfig {
+	return &SpliceConfig{}
+}
+
+// WithFromEnd sets whether negative indices count from the end
+func WithFromEnd(fromEnd bool) SpliceOption {
+	return func(c *SpliceConfig) {
+		c.FromEnd = fromEnd
+	}
+}
+
+// WithStrictBounds sets whether out-of-range indices leave the string
+// unchanged rather than being clamped
+func WithStrictBounds(strict bool) SpliceOption {
+	return func(c *SpliceConfig) {
+		c.Strict = strict
+	}
+}
+
+// Splice replaces the runes of s in [start, end) with replacement. Indices
+// are clamped to s by default, and an end before start inserts replacement
+// at start.
+//
+// Example:
+//
+//	Splice("café au lait", 5, 7, "with")                   // café with lait
+//	Splice("report.txt", -4, 10, ".md", WithFromEnd(true)) // report.md
+//	Splice("abc", 2, 9, "x", WithStrictBounds(true))       // abc
+func Splice(s string, start, end int, replacement string, opts ...SpliceOption) string {
+	return splice(s, runeOffsets(s), start, end, replacement, opts)
+}
+
+// SpliceGraphemes is like Splice, but indexes grapheme clusters, so combining
+// sequences and emoji are never cut in half
+func SpliceGraphemes(s string, start, end int, replacement string, opts ...SpliceOption) string {
+	return splice(s, graphemeOffsets(s), start, end, replacement, opts)
+}
+
+// Overlay writes overlay over s starting at rune index start, replacing as
+// many runes as overlay has and extending s if it runs past the end. Only
+// start is checked against the bounds of s.
+//
+// Example:
+//
+//	Overlay("2024-01-15", 5, "12") // 2024-12-15
+//	Overlay("abc", 2, "xyz")       // abxyz
+func Overlay(s string, start int, overlay string, opts ...SpliceOption) string {
+	config := newSpliceConfig(opts)
+	offsets := runeOffsets(s)
+	start, ok := config.resolve(start, len(offsets)-1)
+	if !ok {
+		return s
+	}
+	end := min(start+utf8.RuneCountInString(overlay), len(offsets)-1)
+	return s[:offsets[start]] + overlay + s[offsets[end]:]
+}
+
+// newSpliceConfig returns the configuration built from opts
+func newSpliceConfig(opts []SpliceOption) *SpliceConfig {
+	config := defaultSpliceConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// splice replaces the units of s in [start, end), where offsets are the byte
+// offsets of the units followed by len(s)
+func splice(s string, offsets []int, start, end int, replacement string, opts []SpliceOption) string {
+	config := newSpliceConfig(opts)
+	n := len(offsets) - 1
+	start, ok := config.resolve(start, n)
+	if !ok {
+		return s
+	}
+	end, ok = config.resolve(end, n)
+	if !ok || config.Strict && end < start {
+		return s
+	}
+	end = max(end, start)
+	return s[:offsets[start]] + replacement + s[offsets[end]:]
+}
+
+// resolve returns index i into n units as a position in [0, n], counting
+// negative indices from the end if configured. ok is false if i is out of
+// range in strict mode.
+func (c *SpliceConfig) resolve(i, n int) (int, bool) {
+	if c.FromEnd && i < 0 {
+		i += n
+	}
+	if c.Strict && (i < 0 || i > n) {
+		return 0, false
+	}
+	return clamp(i, 0, n), true
+}
@@ -0,0 +1,91 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		start, end  int
+		replacement string
+		options     []sx.SpliceOption
+		expected    string
+	}{
+		{name: "replace", input: "caf\u00e9 au lait", start: 5, end: 7, replacement: "with", expected: "caf\u00e9 with lait"},
+		{name: "insert", input: "abc", start: 1, end: 1, replacement: "-", expected: "a-bc"},
+		{name: "delete", input: "abcdef", start: 1, end: 4, replacement: "", expected: "aef"},
+		{name: "end before start inserts", input: "abc", start: 2, end: 0, replacement: "x", expected: "abxc"},
+		{name: "clamped", input: "abc", start: -5, end: 9, replacement: "x", expected: "x"},
+		{name: "append", input: "abc", start: 3, end: 3, replacement: "d", expected: "abcd"},
+		{name: "from end", input: "report.txt", start: -4, end: 10, replacement: ".md", options: []sx.SpliceOption{sx.WithFromEnd(true)}, expected: "report.md"},
+		{name: "from end both", input: "abcdef", start: -3, end: -1, replacement: "X", options: []sx.SpliceOption{sx.WithFromEnd(true)}, expected: "abcXf"},
+		{name: "strict out of range", input: "abc", start: 2, end: 9, replacement: "x", options: []sx.SpliceOption{sx.WithStrictBounds(true)}, expected: "abc"},
+		{name: "strict negative", input: "abc", start: -1, end: 2, replacement: "x", options: []sx.SpliceOption{sx.WithStrictBounds(true)}, expected: "abc"},
+		{name: "strict reversed", input: "abc", start: 2, end: 1, replacement: "x", options: []sx.SpliceOption{sx.WithStrictBounds(true)}, expected: "abc"},
+		{name: "strict in range", input: "abc", start: 0, end: 3, replacement: "x", options: []sx.SpliceOption{sx.WithStrictBounds(true)}, expected: "x"},
+		{name: "empty", input: "", start: 0, end: 0, replacement: "x", expected: "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.Splice(tt.input, tt.start, tt.end, tt.replacement, tt.options...); result != tt.expected {
+				t.Errorf("Splice(%q, %d, %d, %q) = %q, want %q", tt.input, tt.start, tt.end, tt.replacement, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpliceGraphemes(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		start, end  int
+		replacement string
+		expected    string
+	}{
+		{name: "combining mark kept whole", input: "cafe\u0301 noir", start: 3, end: 4, replacement: "\u00e9", expected: "caf\u00e9 noir"},
+		{name: "emoji sequence", input: "a\U0001F468\u200d\U0001F469\u200d\U0001F467b", start: 1, end: 2, replacement: "-", expected: "a-b"},
+		{name: "flags", input: "\U0001F1EF\U0001F1F5\U0001F1EB\U0001F1F7", start: 1, end: 2, replacement: "", expected: "\U0001F1EF\U0001F1F5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.SpliceGraphemes(tt.input, tt.start, tt.end, tt.replacement); result != tt.expected {
+				t.Errorf("SpliceGraphemes(%q, %d, %d, %q) = %q, want %q", tt.input, tt.start, tt.end, tt.replacement, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		start    int
+		overlay  string
+		options  []sx.SpliceOption
+		expected string
+	}{
+		{name: "inside", input: "2024-01-15", start: 5, overlay: "12", expected: "2024-12-15"},
+		{name: "past end", input: "abc", start: 2, overlay: "xyz", expected: "abxyz"},
+		{name: "runes", input: "h\u00e9llo", start: 1, overlay: "e", expected: "hello"},
+		{name: "start clamped", input: "abc", start: 7, overlay: "d", expected: "abcd"},
+		{name: "from end", input: "file.txt", start: -3, overlay: "log", options: []sx.SpliceOption{sx.WithFromEnd(true)}, expected: "file.log"},
+		{name: "strict out of range", input: "abc", start: 4, overlay: "d", options: []sx.SpliceOption{sx.WithStrictBounds(true)}, expected: "abc"},
+		{name: "empty overlay", input: "abc", start: 1, overlay: "", expected: "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.Overlay(tt.input, tt.start, tt.overlay, tt.options...); result != tt.expected {
+				t.Errorf("Overlay(%q, %d, %q) = %q, want %q", tt.input, tt.start, tt.overlay, result, tt.expected)
+			}
+		})
+	}
+}
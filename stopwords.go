@@ -0,0 +1,83 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// stopWordLists holds, per language, the articles, prepositions, conjunctions
+// and other short function words that carry little meaning in slugs, titles
+// and identifiers
+var stopWordLists = map[string]string{
+	"en": `a an and as at but by for from if in into is it nor of off on onto or
+		over per so than that the to up via with yet`,
+	"de": `am an auf aus bei bis das dem den der des die durch ein eine einem
+		einen einer eines für gegen im in ins mit nach ob oder ohne seit über um
+		und unter vom von vor wie zu zum zur zwischen`,
+	"fr": `à au aux avec car ce ces cet cette chez d dans de des du en et l la le
+		les mais ni ou par pour qu que qui sans sous sur un une vers`,
+	"es": `a al ante bajo con contra de del desde e el en entre hacia hasta la las
+		lo los ni o para pero por que según sin sino sobre tras u un una unas unos
+		y`,
+	"it": `a ad agli ai al alla alle che con da dal dalla degli dei del della delle
+		di e ed fra gli i il in la le lo ma nel nella o per su sul sulla tra un una
+		uno`,
+	"pt": `a à ao aos as às até com da das de do dos e em entre mas na nas no nos
+		o os ou para pela pelo por que sem sob sobre um uma umas uns`,
+	"nl": `aan als bij dan dat de die door een en het in maar met naar of om onder
+		op over te tot uit van voor`,
+}
+
+// stopWordSets indexes stopWordLists for lookups
+var stopWordSets = sync.OnceValue(func() map[string]map[string]bool {
+	sets := make(map[string]map[string]bool, len(stopWordLists))
+	for lang, list := range stopWordLists {
+		set := make(map[string]bool)
+		for _, word := range strings.Fields(list) {
+			set[word] = true
+		}
+		sets[lang] = set
+	}
+	return sets
+})
+
+// WithStopWordLang selects the stop word list used by features that drop
+// insignificant words, such as Abbreviate. lang is a language tag like "en",
+// "de" or "pt-BR"; only its language subtag is used. Unsupported languages
+// have no stop words.
+func WithStopWordLang(lang string) Option {
+	return func(c *Config) {
+		c.StopWordLang = lang
+	}
+}
+
+// StopWordLangs returns the languages with a built-in stop word list, sorted
+func StopWordLangs() []string {
+	return slices.Sorted(maps.Keys(stopWordLists))
+}
+
+// StopWords returns the stop words of lang, sorted, or nil when the language
+// is not supported
+//
+// Example:
+//
+//	StopWords("de-AT") // [am an auf aus bei ...]
+func StopWords(lang string) []string {
+	_, lang = parseLocale(lang)
+	return slices.Sorted(maps.Keys(stopWordSets()[lang]))
+}
+
+// IsStopWord reports whether word is a stop word in lang, ignoring case
+//
+// Example:
+//
+//	IsStopWord("The", "en") // true
+//	IsStopWord("für", "de") // true
+func IsStopWord(word, lang string) bool {
+	_, lang = parseLocale(lang)
+	return stopWordSets()[lang][strings.ToLower(word)]
+}
@@ -0,0 +1,60 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestIsStopWord(t *testing.T) {
+	tests := []struct {
+		word     string
+		lang     string
+		expected bool
+	}{
+		{word: "the", lang: "en", expected: true},
+		{word: "The", lang: "en", expected: true},
+		{word: "table", lang: "en", expected: false},
+		{word: "f\u00fcr", lang: "de", expected: true},
+		{word: "F\u00dcR", lang: "de-AT", expected: true},
+		{word: "\u00e0", lang: "fr", expected: true},
+		{word: "seg\u00fan", lang: "es", expected: true},
+		{word: "della", lang: "it", expected: true},
+		{word: "pelo", lang: "pt_BR", expected: true},
+		{word: "het", lang: "nl", expected: true},
+		{word: "the", lang: "de", expected: false},
+		{word: "the", lang: "xx", expected: false},
+		{word: "the", lang: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+"/"+tt.word, func(t *testing.T) {
+			if result := sx.IsStopWord(tt.word, tt.lang); result != tt.expected {
+				t.Errorf("IsStopWord(%q, %q) = %v, want %v", tt.word, tt.lang, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStopWords(t *testing.T) {
+	for _, lang := range sx.StopWordLangs() {
+		words := sx.StopWords(lang)
+		if len(words) == 0 || !slices.IsSorted(words) {
+			t.Errorf("StopWords(%q) = %q, want a sorted non-empty list", lang, words)
+		}
+		for _, word := range words {
+			if !sx.IsStopWord(word, lang) {
+				t.Errorf("IsStopWord(%q, %q) = false for a listed word", word, lang)
+			}
+		}
+	}
+	if words := sx.StopWords("xx"); words != nil {
+		t.Errorf("StopWords(%q) = %q, want nil", "xx", words)
+	}
+	if langs := sx.StopWordLangs(); !slices.Contains(langs, "en") || !slices.Contains(langs, "de") {
+		t.Errorf("StopWordLangs() = %q, want en and de included", langs)
+	}
+}
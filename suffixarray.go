@@ -0,0 +1,217 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Index is a suffix array over a text, answering substring queries in time
+// proportional to the pattern length and the logarithm of the text length.
+// Build it once with NewIndex and query it many times. Positions are byte
+// offsets into the text. An Index is safe for concurrent use.
+type Index struct {
+	text string
+	// sa holds the starting offsets of all suffixes in lexicographic order
+	sa []int
+	// lcp[i] is the length of the common prefix of the suffixes sa[i-1] and sa[i]
+	lcp []int
+}
+
+// NewIndex builds an Index over text
+func NewIndex(text string) *Index {
+	ix, _ := NewIndexContext(context.Background(), text)
+	return ix
+}
+
+// NewIndexContext builds an Index over text like NewIndex, returning early
+// with the context's error if ctx is done before the build finishes
+func NewIndexContext(ctx context.Context, text string) (*Index, error) {
+	b := []byte(text)
+	sa, err := suffixArray(ctx, b, 256)
+	if err != nil {
+		return nil, err
+	}
+	lcp, err := lcpArray(ctx, b, sa)
+	if err != nil {
+		return nil, err
+	}
+	return &Index{text: text, sa: sa, lcp: lcp}, nil
+}
+
+// cancelCheckInterval is the number of steps of a linear pass between checks
+// for cancellation
+const cancelCheckInterval = 1 << 16
+
+// suffixArray sorts the suffixes of s, whose symbols are in [0, alphabet), by
+// prefix doubling: after the round for k, suffixes are ranked by their first
+// 2k symbols. Each round is a linear-time radix sort on (rank of the first
+// half, rank of the second half). It stops with ctx's error between rounds
+// once ctx is done.
+func suffixArray[T byte | int32](ctx context.Context, s []T, alphabet int) ([]int, error) {
+	n := len(s)
+	sa := make([]int, n)
+	rank := make([]int, n)
+	tmp := make([]int, n)
+	for i := range n {
+		sa[i], rank[i] = i, int(s[i])
+	}
+	slices.SortStableFunc(sa, func(a, b int) int { return cmp.Compare(s[a], s[b]) })
+	classes := alphabet
+
+	for k := 1; n > 1; k *= 2 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Order by the second half: suffixes too short to have one come first
+		p := 0
+		for i := n - k; i < n; i++ {
+			tmp[p] = i
+			p++
+		}
+		for _, i := range sa {
+			if i >= k {
+				tmp[p] = i - k
+				p++
+			}
+		}
+
+		// Stable counting sort by the first half
+		count := make([]int, classes+1)
+		for _, i := range tmp {
+			count[rank[i]+1]++
+		}
+		for c := 1; c <= classes; c++ {
+			count[c] += count[c-1]
+		}
+		for _, i := range tmp {
+			sa[count[rank[i]]] = i
+			count[rank[i]]++
+		}
+
+		// Re-rank, giving equal pairs equal ranks
+		second := func(i int) int {
+			if i+k < n {
+				return rank[i+k]
+			}
+			return -1
+		}
+		tmp[sa[0]] = 0
+		for j := 1; j < n; j++ {
+			a, b := sa[j-1], sa[j]
+			tmp[b] = tmp[a]
+			if rank[a] != rank[b] || second(a) != second(b) {
+				tmp[b]++
+			}
+		}
+		rank, tmp = tmp, rank
+		classes = rank[sa[n-1]] + 1
+		if classes == n {
+			break
+		}
+	}
+	return sa, nil
+}
+
+// lcpArray computes the longest common prefix of adjacent suffixes in sa
+// using Kasai's algorithm, stopping with ctx's error once ctx is done
+func lcpArray[T byte | int32](ctx context.Context, s []T, sa []int) ([]int, error) {
+	n := len(s)
+	rank := make([]int, n)
+	for i, p := range sa {
+		rank[p] = i
+	}
+
+	lcp := make([]int, n)
+	h := 0
+	for p := range n {
+		if p%cancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		if rank[p] == 0 {
+			h = 0
+			continue
+		}
+		q := sa[rank[p]-1]
+		for p+h < n && q+h < n && s[p+h] == s[q+h] {
+			h++
+		}
+		lcp[rank[p]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return lcp, nil
+}
+
+// Text returns the indexed text
+func (ix *Index) Text() string {
+	return ix.text
+}
+
+// lookup returns the range of sa whose suffixes start with pattern
+func (ix *Index) lookup(pattern string) (lo, hi int) {
+	lo = sort.Search(len(ix.sa), func(i int) bool {
+		return ix.text[ix.sa[i]:] >= pattern
+	})
+	hi = lo + sort.Search(len(ix.sa)-lo, func(i int) bool {
+		return !strings.HasPrefix(ix.text[ix.sa[lo+i]:], pattern)
+	})
+	return lo, hi
+}
+
+// Find returns the byte offsets of every occurrence of pattern in the text,
+// including overlapping ones, in increasing order. An empty pattern matches
+// nothing.
+func (ix *Index) Find(pattern string) []int {
+	if pattern == "" {
+		return nil
+	}
+	lo, hi := ix.lookup(pattern)
+	if lo == hi {
+		return nil
+	}
+	offsets := slices.Clone(ix.sa[lo:hi])
+	slices.Sort(offsets)
+	return offsets
+}
+
+// CountOccurrences returns the number of occurrences of pattern in the text,
+// including overlapping ones
+func (ix *Index) CountOccurrences(pattern string) int {
+	if pattern == "" {
+		return 0
+	}
+	lo, hi := ix.lookup(pattern)
+	return hi - lo
+}
+
+// LongestRepeated returns the longest substring that occurs at least twice in
+// the text, possibly overlapping, or "" if no character repeats. The result
+// never splits a UTF-8 sequence; among equally long candidates the
+// lexicographically smallest is returned.
+func (ix *Index) LongestRepeated() string {
+	best, bestStart := 0, 0
+	for i := 1; i < len(ix.sa); i++ {
+		start, n := ix.sa[i], ix.lcp[i]
+		if n <= best || !utf8.RuneStart(ix.text[start]) {
+			continue
+		}
+		// Trim a partial rune at the end of the common prefix
+		for n > 0 && start+n < len(ix.text) && !utf8.RuneStart(ix.text[start+n]) {
+			n--
+		}
+		if n > best {
+			best, bestStart = n, start
+		}
+	}
+	return ix.text[bestStart : bestStart+best]
+}
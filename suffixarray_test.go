@@ -0,0 +1,96 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestIndex_Find(t *testing.T) {
+	ix := sx.NewIndex("banana bandana")
+
+	tests := []struct {
+		pattern  string
+		expected []int
+	}{
+		{pattern: "ana", expected: []int{1, 3, 11}},
+		{pattern: "ban", expected: []int{0, 7}},
+		{pattern: "a", expected: []int{1, 3, 5, 8, 11, 13}},
+		{pattern: "banana bandana", expected: []int{0}},
+		{pattern: "xyz", expected: nil},
+		{pattern: "bandanas", expected: nil},
+		{pattern: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			result := ix.Find(tt.pattern)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("Find(%q) = %v, want %v", tt.pattern, result, tt.expected)
+			}
+			if count := ix.CountOccurrences(tt.pattern); count != len(tt.expected) {
+				t.Errorf("CountOccurrences(%q) = %d, want %d", tt.pattern, count, len(tt.expected))
+			}
+		})
+	}
+}
+
+func TestIndex_MatchesNaiveSearch(t *testing.T) {
+	text := strings.Repeat("abracadabra ", 20) + "cadabra"
+	ix := sx.NewIndex(text)
+	for _, pattern := range []string{"abra", "cad", "a", "ra c", "dabra cadabra"} {
+		if result, expected := ix.Find(pattern), sx.IndexAll(text, pattern, true); !slices.Equal(result, expected) {
+			t.Errorf("Find(%q) = %v, want %v", pattern, result, expected)
+		}
+	}
+}
+
+func TestIndex_LongestRepeated(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "banana", text: "banana", expected: "ana"},
+		{name: "words", text: "the cat sat on the mat with the cat", expected: "the cat"},
+		{name: "overlap", text: "aaaa", expected: "aaa"},
+		{name: "no repeat", text: "abc", expected: ""},
+		{name: "empty", text: "", expected: ""},
+		{name: "utf-8 rune", text: "x\u00e91 y\u00e92", expected: "\u00e9"},
+		{name: "utf-8 partial rune", text: "x\u00e9 y\u00ea", expected: ""},
+		{name: "tie picks smallest", text: "abXYab XY", expected: "XY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.NewIndex(tt.text).LongestRepeated()
+			if result != tt.expected {
+				t.Errorf("LongestRepeated(%q) = %q, want %q", tt.text, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewIndexContext(t *testing.T) {
+	text := strings.Repeat("abracadabra ", 20)
+
+	ix, err := sx.NewIndexContext(context.Background(), text)
+	if err != nil {
+		t.Fatalf("NewIndexContext() error = %v", err)
+	}
+	if result, expected := ix.Find("cad"), sx.NewIndex(text).Find("cad"); !slices.Equal(result, expected) {
+		t.Errorf("Find(%q) = %v, want %v", "cad", result, expected)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if ix, err := sx.NewIndexContext(ctx, text); !errors.Is(err, context.Canceled) || ix != nil {
+		t.Errorf("NewIndexContext(canceled) = %v, %v, want nil, context.Canceled", ix, err)
+	}
+}
@@ -1,9 +1,9 @@
 package sx
 
 import (
+	"iter"
 	"slices"
 	"strings"
-	"unicode"
 	"unicode/utf8"
 )
 
@@ -23,23 +23,23 @@ func isSeparatorCustom(r rune, separators []rune) bool {
 // isLetterCaseChange detects case transitions (like camelCase -> camel Case)
 func isLetterCaseChange(prev, curr, next rune) bool {
 	// Handle letter-to-letter case changes
-	if unicode.IsLetter(prev) && unicode.IsLetter(curr) {
+	if isLetter(prev) && isLetter(curr) {
 		// Lower to Upper transition (camelCase -> camel Case)
-		if unicode.IsLower(prev) && unicode.IsUpper(curr) {
+		if isLower(prev) && isUpper(curr) {
 			return true
 		}
 
 		// Upper to Lower with next also lower (XMLHttpRequest -> XML Http Request)
 		// But for cases like "FooBARb", we want: Foo-BA-Rb
 		// So we split before the last uppercase in a sequence when followed by lowercase
-		if unicode.IsUpper(prev) && unicode.IsUpper(curr) && unicode.IsLetter(next) && unicode.IsLower(next) {
+		if isUpper(prev) && isUpper(curr) && isLetter(next) && isLower(next) {
 			return true
 		}
 	}
 
 	// Handle number to letter transitions (HTML5Parser -> HTML5 Parser)
-	if (unicode.IsDigit(prev) || unicode.IsLetter(prev)) && unicode.IsLetter(curr) {
-		if unicode.IsDigit(prev) && unicode.IsUpper(curr) {
+	if (isDigit(prev) || isLetter(prev)) && isLetter(curr) {
+		if isDigit(prev) && isUpper(curr) {
 			return true
 		}
 	}
@@ -47,109 +47,101 @@ func isLetterCaseChange(prev, curr, next rune) bool {
 	return false
 }
 
-// splitByCaseWithCustomSeparators splits a string into words with optional custom separators
-func splitByCaseWithCustomSeparators(s string, customSeparators []rune) []string {
-	if s == "" {
-		return []string{}
-	}
-
-	runes := []rune(s)
-	var words []string
-	var currentWord strings.Builder
-
-	for i, r := range runes {
-		var prevRune, nextRune rune
-		if i > 0 {
-			prevRune = runes[i-1]
-		}
-		if i < len(runes)-1 {
-			nextRune = runes[i+1]
-		}
+// wordScanner splits a string into words at separators and case changes.
+// Words are subslices of the string, so scanning does not allocate.
+type wordScanner struct {
+	s      string
+	config *Config
+	// i is the offset of the next rune to examine and start that of the current word
+	i, start int
+	prev     rune
+}
 
-		// Check if we should start a new word
-		shouldSplit := false
-		skipCurrentRune := false
-
-		// Check separators (custom if provided, otherwise default)
-		var isSep bool
-		if customSeparators != nil {
-			// Custom separators specified - only split on those (could be empty list)
-			isSep = isSeparatorCustom(r, customSeparators)
-		} else {
-			// No custom separators specified - use defaults
-			isSep = isSeparator(r)
+// next returns the next word, or false when s is exhausted. Consecutive
+// separators produce empty words.
+func (w *wordScanner) next() (string, bool) {
+	for w.i < len(w.s) {
+		i := w.i
+		r, size := utf8.DecodeRuneInString(w.s[i:])
+		var next rune
+		if i+size < len(w.s) {
+			next, _ = utf8.DecodeRuneInString(w.s[i+size:])
 		}
+		prev := w.prev
+		w.i, w.prev = i+size, r
 
-		if isSep {
+		switch {
+		case w.config.isSeparator(r):
 			// Skip separator and start new word
-			shouldSplit = true
-			skipCurrentRune = true
-		} else if i > 0 && isLetterCaseChange(prevRune, r, nextRune) {
-			// Case change detected
-			shouldSplit = true
-		}
-
-		// If we should split, finalize current word (even if empty to handle consecutive separators)
-		if shouldSplit {
-			word := strings.TrimSpace(currentWord.String())
-			// Always add the word, even if empty (for consecutive separators)
-			words = append(words, word)
-			currentWord.Reset()
-		}
-
-		// Add current rune to the word unless it's a separator we're skipping
-		if !skipCurrentRune {
-			currentWord.WriteRune(r)
+			word := strings.TrimSpace(w.s[w.start:i])
+			w.start = w.i
+			return word, true
+		case i > 0 && isLetterCaseChange(prev, r, next):
+			word := strings.TrimSpace(w.s[w.start:i])
+			w.start = i
+			return word, true
 		}
 	}
 
 	// Add the last word
-	if currentWord.Len() > 0 {
-		word := strings.TrimSpace(currentWord.String())
-		words = append(words, word)
+	if w.start < len(w.s) {
+		word := strings.TrimSpace(w.s[w.start:])
+		w.start = len(w.s)
+		return word, true
 	}
-
-	return words
+	return "", false
 }
 
-// SplitOption configures how SplitByCase splits strings
-type SplitOption func(*SplitConfig)
-
-// SplitConfig holds the configuration for splitting behavior
-type SplitConfig struct {
-	Separators []rune
+// scanWords appends the words of s to dst, splitting at the configured
+// separators and at case changes
+func (c *Config) scanWords(dst []string, s string) []string {
+	w := wordScanner{s: s, config: c}
+	for word, ok := w.next(); ok; word, ok = w.next() {
+		dst = append(dst, word)
+	}
+	return dst
 }
 
-// defaultSplitConfig returns the default configuration
-func defaultSplitConfig() *SplitConfig {
-	return &SplitConfig{
-		Separators: nil, // nil means use defaults
-	}
+// SplitByCase splits a string into words based on case changes and separators
+// Accepts optional configuration via functional options
+func SplitByCase(s string, opts ...Option) Words {
+	return newConfig(opts).split(s)
 }
 
-// WithSeparators sets custom separator runes (replaces defaults)
-func WithSeparators(separators ...rune) SplitOption {
-	return func(c *SplitConfig) {
-		c.Separators = make([]rune, len(separators))
-		copy(c.Separators, separators)
-	}
+// SplitByCaseAppend appends the words of s to dst and returns the extended
+// slice, like SplitByCase. The words are subslices of s rather than copies,
+// unless invalid UTF-8 in s is replaced or dropped, so reusing dst across
+// calls avoids allocations in tight loops.
+//
+// Example:
+//
+//	var words []string
+//	for _, name := range names {
+//		words = SplitByCaseAppend(words[:0], name)
+//		// use words
+//	}
+func SplitByCaseAppend(dst []string, s string, opts ...Option) []string {
+	return configFor(opts).appendSplit(dst, s)
 }
 
-// SplitByCase splits a string into words based on case changes and separators
-// Accepts optional configuration via functional options
-func SplitByCase(s string, opts ...SplitOption) []string {
-	config := defaultSplitConfig()
-	for _, opt := range opts {
-		opt(config)
+// SplitByCaseSeq returns an iterator over the words of s, like SplitByCase.
+// The words are subslices of s rather than copies, unless invalid UTF-8 in s
+// is replaced or dropped.
+func SplitByCaseSeq(s string, opts ...Option) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var buf [16]string
+		for _, word := range configFor(opts).appendSplit(buf[:0], s) {
+			if !yield(word) {
+				return
+			}
+		}
 	}
-
-	return splitByCaseWithCustomSeparators(s, config.Separators)
 }
 
 // normalizeWord normalizes a word's case if needed
 func normalizeWord(word string, normalize bool) string {
 	if normalize {
-		return strings.ToLower(word)
+		return toLowerString(word)
 	}
 	return word
 }
@@ -165,7 +157,7 @@ func capitalizeWord(word string) string {
 		return word
 	}
 
-	return string(unicode.ToUpper(r)) + word[size:]
+	return string(toUpper(r)) + word[size:]
 }
 
 // joinWords joins words with a separator
@@ -197,52 +189,41 @@ func joinWords(words []string, separator string, preserveEmpty bool, transform f
 	return result.String()
 }
 
-type CaseOption func(*CaseConfig)
+// StringOrStringSlice represents input that can be either a string or slice
+// of strings, including the Words returned by SplitByCase
+type StringOrStringSlice interface {
+	string | []string | Words
+}
 
-// CaseConfig configures case conversion behavior
-type CaseConfig struct {
-	// If an uppercase letter is followed by other uppercase letters (like FooBAR), they are preserved. You can use sx.WithNormalize(true) for strictly following PascalCase convention.
-	Normalize bool
+// PascalCase converts input to PascalCase
+func PascalCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return configFor(opts).pascalCase(input)
 }
 
-// WithNormalize sets the normalize option
-func WithNormalize(normalize bool) CaseOption {
-	return func(c *CaseConfig) {
-		c.Normalize = normalize
+// pascalCase converts input to PascalCase, see PascalCase
+func (c *Config) pascalCase(input any) string {
+	if s, ok := c.unchanged(input, "", c.keepsCapitalized); ok {
+		return s
+	}
+	if s, ok := c.streamable(input); ok {
+		return c.streamCapitalized(s, "")
 	}
-}
 
-// StringOrStringSlice represents input that can be either a string or slice of strings
-type StringOrStringSlice interface {
-	string | []string
+	return capitalizeWords(c, c.inputWords(input), "")
 }
 
-// PascalCase converts input to PascalCase
-func PascalCase[T StringOrStringSlice](input T, opts ...CaseOption) string {
-	options := CaseConfig{}
-	for _, opt := range opts {
-		opt(&options)
-	}
-
-	switch v := any(input).(type) {
-	case string:
-		words := splitByCaseWithCustomSeparators(v, nil)
-		result := joinWords(words, "", false, func(word string, i int) string {
-			normalized := normalizeWord(word, options.Normalize)
-			return capitalizeWord(normalized)
-		})
-
-		return result
-	case []string:
-		result := joinWords(v, "", false, func(word string, i int) string {
-			normalized := normalizeWord(word, options.Normalize)
-			return capitalizeWord(normalized)
-		})
-
-		return result
-	default:
-		return ""
-	}
+// capitalizeWords joins words with separator, capitalizing each one except
+// protected words and rendering acronyms
+func capitalizeWords(options *Config, words []string, separator string) string {
+	return joinWords(words, separator, false, func(word string, i int) string {
+		if spelling, ok := options.protected[word]; ok {
+			return spelling
+		}
+		if acronym, ok := options.renderAcronym(word); ok {
+			return acronym
+		}
+		return capitalizeWord(normalizeWord(word, options.Normalize))
+	})
 }
 
 // lowercaseWord converts the first letter to lowercase
@@ -256,97 +237,126 @@ func lowercaseWord(word string) string {
 		return word
 	}
 
-	return string(unicode.ToLower(r)) + word[size:]
+	return string(toLower(r)) + word[size:]
 }
 
 // CamelCase converts input to camelCase
-func CamelCase[T StringOrStringSlice](input T, opts ...CaseOption) string {
-	switch v := any(input).(type) {
-	case string:
-		pascalCase := PascalCase(v, opts...)
-		return lowercaseWord(pascalCase)
-	case []string:
-		if len(v) == 0 {
-			return ""
-		}
-
-		options := CaseConfig{}
-		for _, opt := range opts {
-			opt(&options)
-		}
+func CamelCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return configFor(opts).camelCase(input)
+}
 
-		result := joinWords(v, "", false, func(word string, i int) string {
-			normalized := normalizeWord(word, options.Normalize)
-			if i == 0 {
-				return lowercaseWord(normalized)
-			}
+// camelCase converts input to camelCase, see CamelCase
+func (c *Config) camelCase(input any) string {
+	if s, ok := c.unchanged(input, "", c.keepsCamel); ok {
+		return s
+	}
+	if s, ok := c.streamable(input); ok {
+		return c.streamCamel(s)
+	}
 
-			return capitalizeWord(normalized)
-		})
-		return result
-	default:
+	words := c.inputWords(input)
+	if len(words) == 0 {
 		return ""
 	}
+
+	return joinWords(words, "", false, func(word string, i int) string {
+		if spelling, ok := c.protected[word]; ok {
+			return spelling
+		}
+		acronym, isAcronym := c.renderAcronym(word)
+		if i == 0 {
+			if isAcronym {
+				return toLowerString(word)
+			}
+			return lowercaseWord(normalizeWord(word, c.Normalize))
+		}
+
+		if isAcronym {
+			return acronym
+		}
+		normalized := normalizeWord(word, c.Normalize)
+		return capitalizeWord(normalized)
+	})
 }
 
-// KebabCase converts input to kebab-case
+// KebabCase converts input to kebab-case, or to lowercase words joined by
+// separator when one is given. It takes no options; use
+// DelimitedCase(input, "-", opts...) to pass them.
 func KebabCase[T StringOrStringSlice](input T, separator ...string) string {
 	sep := "-"
 	if len(separator) > 0 {
 		sep = separator[0]
 	}
 
-	switch v := any(input).(type) {
-	case string:
-		words := splitByCaseWithCustomSeparators(v, nil)
-		result := joinWords(words, sep, true, func(word string, i int) string {
-			return strings.ToLower(word)
-		})
-		return result
-	case []string:
-		result := joinWords(v, sep, true, func(word string, i int) string {
-			return strings.ToLower(word)
-		})
-		return result
-	default:
-		return ""
+	return DelimitedCase(input, sep)
+}
+
+// DelimitedCase converts input to lowercase words joined by separator, the
+// general form of KebabCase, SnakeCase and FlatCase that also accepts options:
+//
+//	DelimitedCase("fooBar", ".") // foo.bar
+func DelimitedCase[T StringOrStringSlice](input T, separator string, opts ...Option) string {
+	return configFor(opts).delimitedCase(input, separator)
+}
+
+// delimitedCase converts input to lowercase words joined by separator, see
+// DelimitedCase
+func (c *Config) delimitedCase(input any, separator string) string {
+	if s, ok := c.unchanged(input, separator, keepsDelimited); ok {
+		return s
+	}
+	if s, ok := c.streamable(input); ok {
+		return c.streamDelimited(s, separator)
 	}
+
+	words := c.inputWords(input)
+
+	return joinWords(words, separator, true, func(word string, i int) string {
+		if spelling, ok := c.protected[word]; ok {
+			return spelling
+		}
+		return toLowerString(word)
+	})
 }
 
 // SnakeCase converts input to snake_case
-func SnakeCase[T StringOrStringSlice](input T) string {
-	return KebabCase(input, "_")
+func SnakeCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return DelimitedCase(input, "_", opts...)
+}
+
+// SpaceCase converts input to lowercase words separated by spaces, the base
+// form for search indexing and display:
+//
+//	SpaceCase("XMLHttpRequest") // xml http request
+func SpaceCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return DelimitedCase(input, " ", opts...)
+}
+
+// NoCase is an alias for SpaceCase
+func NoCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return DelimitedCase(input, " ", opts...)
 }
 
 // TrainCase converts input to Train-Case
-func TrainCase[T StringOrStringSlice](input T, opts ...CaseOption) string {
-	options := CaseConfig{}
-	for _, opt := range opts {
-		opt(&options)
-	}
+func TrainCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return configFor(opts).trainCase(input)
+}
 
-	switch v := any(input).(type) {
-	case string:
-		words := splitByCaseWithCustomSeparators(v, nil)
-		result := joinWords(words, "-", false, func(word string, i int) string {
-			normalized := normalizeWord(word, options.Normalize)
-			return capitalizeWord(normalized)
-		})
-		return result
-	case []string:
-		result := joinWords(v, "-", false, func(word string, i int) string {
-			normalized := normalizeWord(word, options.Normalize)
-			return capitalizeWord(normalized)
-		})
-		return result
-	default:
-		return ""
+// trainCase converts input to Train-Case, see TrainCase
+func (c *Config) trainCase(input any) string {
+	if s, ok := c.unchanged(input, "-", c.keepsCapitalized); ok {
+		return s
 	}
+	if s, ok := c.streamable(input); ok {
+		return c.streamCapitalized(s, "-")
+	}
+
+	return capitalizeWords(c, c.inputWords(input), "-")
 }
 
 // FlatCase converts input to flatcase (no separators)
-func FlatCase[T StringOrStringSlice](input T) string {
-	return KebabCase(input, "")
+func FlatCase[T StringOrStringSlice](input T, opts ...Option) string {
+	return DelimitedCase(input, "", opts...)
 }
 
 // UpperFirst converts the first character to uppercase
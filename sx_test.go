@@ -11,7 +11,7 @@ func TestSplitByCase(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    string
-		expected []string
+		expected sx.Words
 	}{
 		{
 			name:     "camelCase",
@@ -170,6 +170,67 @@ func TestSplitByCase_CustomSeparators(t *testing.T) {
 	}
 }
 
+func TestSplitByCaseAppend(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst      []string
+		input    string
+		options  []sx.Option
+		expected []string
+	}{
+		{name: "empty dst", input: "fooBarBaz", expected: []string{"foo", "Bar", "Baz"}},
+		{name: "appends to dst", dst: []string{"x"}, input: "foo_bar", expected: []string{"x", "foo", "bar"}},
+		{name: "empty input", dst: []string{"x"}, input: "", expected: []string{"x"}},
+		{name: "consecutive separators", input: "a__b", expected: []string{"a", "", "b"}},
+		{name: "options", input: "a.b_c", options: []sx.Option{sx.WithSeparators('.')}, expected: []string{"a", "b_c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.SplitByCaseAppend(tt.dst, tt.input, tt.options...)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SplitByCaseAppend(%q, %q) = %q, want %q", tt.dst, tt.input, result, tt.expected)
+			}
+
+			var seq []string
+			for word := range sx.SplitByCaseSeq(tt.input, tt.options...) {
+				seq = append(seq, word)
+			}
+			if !reflect.DeepEqual(append(tt.dst[:len(tt.dst):len(tt.dst)], seq...), tt.expected) {
+				t.Errorf("SplitByCaseSeq(%q) = %q, want %q", tt.input, seq, tt.expected[len(tt.dst):])
+			}
+		})
+	}
+}
+
+func TestSplitByCaseAppendAllocs(t *testing.T) {
+	dst := make([]string, 0, 8)
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = sx.SplitByCaseAppend(dst[:0], "XMLHttpRequest_handler-v2")
+	})
+	if allocs != 0 {
+		t.Errorf("SplitByCaseAppend allocated %v times per call, want 0", allocs)
+	}
+}
+
+func TestConversionAllocs(t *testing.T) {
+	converters := map[string]func(string, ...sx.Option) string{
+		"pascal": sx.PascalCase[string],
+		"camel":  sx.CamelCase[string],
+		"snake":  sx.SnakeCase[string],
+		"train":  sx.TrainCase[string],
+		"flat":   sx.FlatCase[string],
+	}
+	for name, convert := range converters {
+		allocs := testing.AllocsPerRun(100, func() {
+			convert("XMLHttpRequest_handler-v2")
+		})
+		if allocs != 1 {
+			t.Errorf("%s allocated %v times per call, want 1", name, allocs)
+		}
+	}
+}
+
 func TestPascalCase(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -578,6 +639,52 @@ func TestFlatCase(t *testing.T) {
 	}
 }
 
+func TestSpaceCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "XMLHttpRequest to space case",
+			input:    "XMLHttpRequest",
+			expected: "xml http request",
+		},
+		{
+			name:     "snake_case to space case",
+			input:    "user_account_id",
+			expected: "user account id",
+		},
+		{
+			name:     "already space case",
+			input:    "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "Title Words to space case",
+			input:    "Hello World",
+			expected: "hello world",
+		},
+		{
+			name:     "empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := sx.SpaceCase(tt.input)
+			if result != tt.expected {
+				t.Errorf("SpaceCase(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+			if result := sx.NoCase(tt.input); result != tt.expected {
+				t.Errorf("NoCase(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestEdgeCases(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -620,3 +727,101 @@ func TestEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestAlreadyConverted(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func(string) string
+		input   string
+	}{
+		{name: "snake", convert: func(s string) string { return sx.SnakeCase(s) }, input: "already_snake_case"},
+		{name: "kebab", convert: func(s string) string { return sx.KebabCase(s) }, input: "already-kebab-case"},
+		{name: "flat", convert: func(s string) string { return sx.FlatCase(s) }, input: "alreadyflat"},
+		{name: "camel", convert: func(s string) string { return sx.CamelCase(s) }, input: "alreadyCamelCase"},
+		{name: "pascal", convert: func(s string) string { return sx.PascalCase(s) }, input: "AlreadyPascalCase"},
+		{name: "train", convert: func(s string) string { return sx.TrainCase(s) }, input: "Already-Train-Case"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.convert(tt.input); result != tt.input {
+				t.Fatalf("got %q, want %q", result, tt.input)
+			}
+			if allocs := testing.AllocsPerRun(100, func() { tt.convert(tt.input) }); allocs != 0 {
+				t.Errorf("converting %q allocated %v times, want 0", tt.input, allocs)
+			}
+		})
+	}
+}
+
+// TestConvertedMatchesWords checks that converting a string gives the same
+// result as converting its words, whether or not it is already converted
+func TestConvertedMatchesWords(t *testing.T) {
+	converters := map[string]func(input any, opts ...sx.Option) string{
+		"camel": func(input any, opts ...sx.Option) string {
+			if s, ok := input.(string); ok {
+				return sx.CamelCase(s, opts...)
+			}
+			return sx.CamelCase(input.([]string), opts...)
+		},
+		"pascal": func(input any, opts ...sx.Option) string {
+			if s, ok := input.(string); ok {
+				return sx.PascalCase(s, opts...)
+			}
+			return sx.PascalCase(input.([]string), opts...)
+		},
+		"train": func(input any, opts ...sx.Option) string {
+			if s, ok := input.(string); ok {
+				return sx.TrainCase(s, opts...)
+			}
+			return sx.TrainCase(input.([]string), opts...)
+		},
+		"snake": func(input any, opts ...sx.Option) string {
+			if s, ok := input.(string); ok {
+				return sx.SnakeCase(s, opts...)
+			}
+			return sx.SnakeCase(input.([]string), opts...)
+		},
+		"flat": func(input any, opts ...sx.Option) string {
+			if s, ok := input.(string); ok {
+				return sx.FlatCase(s, opts...)
+			}
+			return sx.FlatCase(input.([]string), opts...)
+		},
+	}
+	options := [][]sx.Option{
+		nil,
+		{sx.WithNormalize(true)},
+		{sx.WithAcronyms("ID", "URL")},
+		{sx.WithAcronymStyle(sx.AcronymCapitalize)},
+		{sx.WithSeparators('_')},
+		{sx.WithInvalidUTF8(sx.UTF8Preserve), sx.WithNormalize(true)},
+	}
+
+	// Every string of up to four runes from a small alphabet
+	alphabet := []rune("aB1_- \u00e9\u01c5")
+	inputs := []string{"ID", "Id", "userID", "User-Id", "user_url", "URLParser", "a\xffB", "\xffb_C\xfe"}
+	var generate func(prefix string, n int)
+	generate = func(prefix string, n int) {
+		inputs = append(inputs, prefix)
+		if n == 0 {
+			return
+		}
+		for _, r := range alphabet {
+			generate(prefix+string(r), n-1)
+		}
+	}
+	generate("", 4)
+
+	for name, convert := range converters {
+		for _, opts := range options {
+			for _, input := range inputs {
+				got := convert(input, opts...)
+				want := convert([]string(sx.SplitByCase(input, opts...)), opts...)
+				if got != want {
+					t.Errorf("%s(%q) = %q, want %q as for its words", name, input, got, want)
+				}
+			}
+		}
+	}
+}
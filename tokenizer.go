@@ -0,0 +1,312 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind identifies the class of a Token
+type TokenKind int
+
+const (
+	// TokenIdent is an identifier such as foo or _bar2
+	TokenIdent TokenKind = iota + 1
+	// TokenNumber is a numeric literal such as 42, 3.14, 1e-9 or 0xFF
+	TokenNumber
+	// TokenString is a quoted string, including its quotes
+	TokenString
+	// TokenOperator is one of the configured operators or punctuation
+	TokenOperator
+	// TokenSpace is a run of whitespace, emitted only with WithTrivia
+	TokenSpace
+	// TokenComment is a line comment, emitted only with WithTrivia
+	TokenComment
+	// TokenCustom is the first kind available for classes added with WithTokenClass
+	TokenCustom TokenKind = 100
+)
+
+// String returns the name of the kind
+func (k TokenKind) String() string {
+	switch k {
+	case TokenIdent:
+		return "ident"
+	case TokenNumber:
+		return "number"
+	case TokenString:
+		return "string"
+	case TokenOperator:
+		return "operator"
+	case TokenSpace:
+		return "space"
+	case TokenComment:
+		return "comment"
+	}
+	if k >= TokenCustom {
+		return "custom"
+	}
+	return "unknown"
+}
+
+// Token is a lexical token and its position in the input
+type Token struct {
+	Kind TokenKind
+	Text string
+	// Offset is the byte offset of the token in the input
+	Offset int
+	// Line and Column are the 1-based line and rune column of the token
+	Line, Column int
+}
+
+// defaultOperators are the operators and punctuation of C-like languages
+var defaultOperators = []string{
+	"+", "-", "*", "/", "%", "=", "==", "!=", "<", "<=", ">", ">=", "!", "&&", "||",
+	"&", "|", "^", "<<", ">>", "++", "--", "+=", "-=", "*=", "/=", ":=", "->", "=>", "::",
+	"(", ")", "[", "]", "{", "}", ",", ";", ".", ":", "?", "@", "#", "$",
+}
+
+// tokenClass is a user-defined token class
+type tokenClass struct {
+	kind  TokenKind
+	match func(s string) int
+}
+
+// TokenizerOption configures a Tokenizer
+type TokenizerOption func(*TokenizerConfig)
+
+// TokenizerConfig holds the configuration of a Tokenizer
+type TokenizerConfig struct {
+	// IdentStart and IdentPart report which runes may start and continue an identifier
+	IdentStart, IdentPart func(rune) bool
+	// Quotes lists the runes that delimit strings
+	Quotes string
+	// Escape is the rune escaping a quote inside strings, or 0 for none
+	Escape rune
+	// Operators lists the operators, matched longest first
+	Operators []string
+	// LineComments lists the prefixes that start a comment running to the end of the line
+	LineComments []string
+	// Trivia emits whitespace and comments as tokens instead of skipping them
+	Trivia bool
+
+	classes []tokenClass
+}
+
+// defaultTokenizerConfig returns the default configuration of a Tokenizer
+func defaultTokenizerConfig() *TokenizerConfig {
+	return &TokenizerConfig{
+		IdentStart: func(r rune) bool { return r == '_' || unicode.IsLetter(r) },
+		IdentPart:  func(r rune) bool { return r == '_' || isLetterOrDigit(r) },
+		Quotes:     `"'`,
+		Escape:     '\\',
+		Operators:  defaultOperators,
+	}
+}
+
+// WithIdentRunes sets the runes that may start and continue an identifier
+// (default letters and '_', then also digits)
+func WithIdentRunes(start, part func(rune) bool) TokenizerOption {
+	return func(c *TokenizerConfig) {
+		c.IdentStart = start
+		c.IdentPart = part
+	}
+}
+
+// WithQuotes sets the runes delimiting strings and the escape rune, 0 for
+// none (default `"'` and '\\')
+func WithQuotes(quotes string, escape rune) TokenizerOption {
+	return func(c *TokenizerConfig) {
+		c.Quotes = quotes
+		c.Escape = escape
+	}
+}
+
+// WithOperators replaces the default operators and punctuation
+func WithOperators(operators ...string) TokenizerOption {
+	return func(c *TokenizerConfig) {
+		c.Operators = operators
+	}
+}
+
+// WithLineComments sets the prefixes starting a comment that runs to the end
+// of the line, e.g. WithLineComments("//", "#")
+func WithLineComments(prefixes ...string) TokenizerOption {
+	return func(c *TokenizerConfig) {
+		c.LineComments = prefixes
+	}
+}
+
+// WithTrivia emits whitespace and comments as TokenSpace and TokenComment
+// tokens instead of skipping them, so the tokens cover the whole input
+func WithTrivia() TokenizerOption {
+	return func(c *TokenizerConfig) {
+		c.Trivia = true
+	}
+}
+
+// WithTokenClass adds a token class tried before the built-in ones. match
+// returns the length in bytes of the token at the start of its argument, or
+// 0 when it does not apply; a length beyond the end of the argument makes
+// Tokenize return a *SyntaxError. Classes are tried in the order they are
+// added.
+func WithTokenClass(kind TokenKind, match func(s string) int) TokenizerOption {
+	return func(c *TokenizerConfig) {
+		c.classes = append(c.classes, tokenClass{kind: kind, match: match})
+	}
+}
+
+// Tokenizer splits input into typed tokens. It is safe for concurrent use.
+type Tokenizer struct {
+	config    *TokenizerConfig
+	operators *PrefixMatcher
+	comments  *PrefixMatcher
+}
+
+// NewTokenizer returns a Tokenizer configured by opts. By default it
+// recognizes identifiers, numbers, single- and double-quoted strings with
+// backslash escapes, and C-like operators, skipping whitespace.
+//
+// Example:
+//
+//	tokens, _ := NewTokenizer().Tokenize(`x := f("a", 1.5)`)
+//	// x  :=  f  (  "a"  ,  1.5  )
+func NewTokenizer(opts ...TokenizerOption) *Tokenizer {
+	config := defaultTokenizerConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &Tokenizer{
+		config:    config,
+		operators: NewPrefixMatcher(config.Operators...),
+		comments:  NewPrefixMatcher(config.LineComments...),
+	}
+}
+
+// Tokenize splits s into tokens. A *SyntaxError is returned for an
+// unterminated string, a character that starts no token, or a token class
+// matching past the end of s.
+func (t *Tokenizer) Tokenize(s string) ([]Token, error) {
+	var tokens []Token
+	line, column := 1, 1
+	for offset := 0; offset < len(s); {
+		kind, n, err := t.scan(s, offset)
+		if err != nil {
+			return tokens, err
+		}
+
+		text := s[offset : offset+n]
+		if t.config.Trivia || kind != TokenSpace && kind != TokenComment {
+			tokens = append(tokens, Token{Kind: kind, Text: text, Offset: offset, Line: line, Column: column})
+		}
+		if newlines := strings.Count(text, "\n"); newlines > 0 {
+			line += newlines
+			column = 1 + utf8.RuneCountInString(text[strings.LastIndexByte(text, '\n')+1:])
+		} else {
+			column += utf8.RuneCountInString(text)
+		}
+		offset += n
+	}
+	return tokens, nil
+}
+
+// scan returns the kind and length of the token at s[offset]
+func (t *Tokenizer) scan(s string, offset int) (TokenKind, int, error) {
+	rest := s[offset:]
+	for _, class := range t.config.classes {
+		n := class.match(rest)
+		if n > len(rest) {
+			return 0, 0, &SyntaxError{Msg: fmt.Sprintf("token class %v matched %d bytes of %d", class.kind, n, len(rest)), Offset: offset}
+		}
+		if n > 0 {
+			return class.kind, n, nil
+		}
+	}
+
+	r, size := utf8.DecodeRuneInString(rest)
+	switch {
+	case unicode.IsSpace(r):
+		n := strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsSpace(r) })
+		if n < 0 {
+			n = len(rest)
+		}
+		return TokenSpace, n, nil
+	case t.comments.HasPrefix(rest):
+		n := strings.IndexByte(rest, '\n')
+		if n < 0 {
+			n = len(rest)
+		}
+		return TokenComment, n, nil
+	case strings.ContainsRune(t.config.Quotes, r):
+		n := t.scanString(rest, r, size)
+		if n < 0 {
+			return 0, 0, &SyntaxError{Msg: "unterminated string", Offset: offset}
+		}
+		return TokenString, n, nil
+	case r >= '0' && r <= '9' || r == '.' && len(rest) > 1 && rest[1] >= '0' && rest[1] <= '9':
+		return TokenNumber, scanNumber(rest), nil
+	case t.config.IdentStart(r):
+		n := strings.IndexFunc(rest[size:], func(r rune) bool { return !t.config.IdentPart(r) })
+		if n < 0 {
+			return TokenIdent, len(rest), nil
+		}
+		return TokenIdent, size + n, nil
+	}
+
+	if op, ok := t.operators.LongestPrefix(rest); ok && op != "" {
+		return TokenOperator, len(op), nil
+	}
+	return 0, 0, &SyntaxError{Msg: fmt.Sprintf("unexpected character %q", r), Offset: offset}
+}
+
+// scanString returns the length of the string starting with quote, or -1
+// when it is not terminated
+func (t *Tokenizer) scanString(s string, quote rune, size int) int {
+	for i := size; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == t.config.Escape && t.config.Escape != 0:
+			_, escaped := utf8.DecodeRuneInString(s[min(i+n, len(s)):])
+			i += n + escaped
+		case r == quote:
+			return i + n
+		default:
+			i += n
+		}
+	}
+	return -1
+}
+
+// scanNumber returns the length of the numeric literal at the start of s:
+// digits with optional underscores, fraction and exponent, or a 0x, 0o or 0b
+// prefixed integer
+func scanNumber(s string) int {
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' || c == '_' }
+	digits := func(i int, valid func(byte) bool) int {
+		for i < len(s) && valid(s[i]) {
+			i++
+		}
+		return i
+	}
+
+	if len(s) > 2 && s[0] == '0' && strings.IndexByte("xXoObB", s[1]) >= 0 {
+		return digits(2, func(c byte) bool { return isASCIIAlnum(c) || c == '_' })
+	}
+
+	i := digits(0, isDigit)
+	if i < len(s) && s[i] == '.' {
+		i = digits(i+1, isDigit)
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			i = digits(j, isDigit)
+		}
+	}
+	return i
+}
@@ -0,0 +1,164 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+// tokenTexts renders tokens as kind:text pairs for comparison
+func tokenTexts(tokens []sx.Token) []string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.Kind.String() + ":" + token.Text
+	}
+	return texts
+}
+
+func TestTokenizer(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []sx.TokenizerOption
+		input    string
+		expected []string
+	}{
+		{
+			name:     "expression",
+			input:    `x := f("a", 1.5)`,
+			expected: []string{"ident:x", "operator::=", "ident:f", "operator:(", `string:"a"`, "operator:,", "number:1.5", "operator:)"},
+		},
+		{
+			name:     "longest operator",
+			input:    "a>=b>c",
+			expected: []string{"ident:a", "operator:>=", "ident:b", "operator:>", "ident:c"},
+		},
+		{
+			name:     "numbers",
+			input:    "42 3.14 .5 1e-9 1_000 0xFF 2E+3",
+			expected: []string{"number:42", "number:3.14", "number:.5", "number:1e-9", "number:1_000", "number:0xFF", "number:2E+3"},
+		},
+		{
+			name:     "escaped quotes",
+			input:    `'it\'s' "say \"hi\""`,
+			expected: []string{`string:'it\'s'`, `string:"say \"hi\""`},
+		},
+		{
+			name:     "unicode identifiers",
+			input:    "caf\u00e9 _x1",
+			expected: []string{"ident:caf\u00e9", "ident:_x1"},
+		},
+		{
+			name:     "comments skipped",
+			opts:     []sx.TokenizerOption{sx.WithLineComments("//", "#")},
+			input:    "a // note\nb # more",
+			expected: []string{"ident:a", "ident:b"},
+		},
+		{
+			name:     "trivia",
+			opts:     []sx.TokenizerOption{sx.WithLineComments("#"), sx.WithTrivia()},
+			input:    "a  # c\nb",
+			expected: []string{"ident:a", "space:  ", "comment:# c", "space:\n", "ident:b"},
+		},
+		{
+			name:     "custom operators",
+			opts:     []sx.TokenizerOption{sx.WithOperators("|>", "|")},
+			input:    "x |> f | g",
+			expected: []string{"ident:x", "operator:|>", "ident:f", "operator:|", "ident:g"},
+		},
+		{
+			name:     "custom quotes",
+			opts:     []sx.TokenizerOption{sx.WithQuotes("`", 0)},
+			input:    "`a\\` b",
+			expected: []string{"string:`a\\`", "ident:b"},
+		},
+		{
+			name:     "custom identifiers",
+			opts:     []sx.TokenizerOption{sx.WithIdentRunes(func(r rune) bool { return r >= 'a' && r <= 'z' }, func(r rune) bool { return r >= 'a' && r <= 'z' || r == '-' })},
+			input:    "kebab-case-name",
+			expected: []string{"ident:kebab-case-name"},
+		},
+		{
+			name: "custom class",
+			opts: []sx.TokenizerOption{sx.WithTokenClass(sx.TokenCustom, func(s string) int {
+				if !strings.HasPrefix(s, "{{") {
+					return 0
+				}
+				if end := strings.Index(s, "}}"); end >= 0 {
+					return end + 2
+				}
+				return 0
+			})},
+			input:    "Hello {{ name }}!",
+			expected: []string{"ident:Hello", "custom:{{ name }}", "operator:!"},
+		},
+		{name: "empty", input: "", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := sx.NewTokenizer(tt.opts...).Tokenize(tt.input)
+			if err != nil {
+				t.Fatalf("Tokenize(%q) error = %v", tt.input, err)
+			}
+			if result := tokenTexts(tokens); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokenizer_Positions(t *testing.T) {
+	tokens, err := sx.NewTokenizer().Tokenize("a = \"\u00e9\"\n  b")
+	if err != nil {
+		t.Fatalf("Tokenize error = %v", err)
+	}
+
+	expected := []sx.Token{
+		{Kind: sx.TokenIdent, Text: "a", Offset: 0, Line: 1, Column: 1},
+		{Kind: sx.TokenOperator, Text: "=", Offset: 2, Line: 1, Column: 3},
+		{Kind: sx.TokenString, Text: "\"\u00e9\"", Offset: 4, Line: 1, Column: 5},
+		{Kind: sx.TokenIdent, Text: "b", Offset: 11, Line: 2, Column: 3},
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("Tokenize = %+v, want %+v", tokens, expected)
+	}
+}
+
+func TestTokenizer_Errors(t *testing.T) {
+	overlong := sx.WithTokenClass(sx.TokenCustom, func(s string) int {
+		if strings.HasPrefix(s, "@") {
+			return len(s) + 1
+		}
+		return 0
+	})
+
+	tests := []struct {
+		name   string
+		opts   []sx.TokenizerOption
+		input  string
+		offset int
+	}{
+		{name: "unterminated string", input: `a = "abc`, offset: 4},
+		{name: "trailing escape", input: `"abc\`, offset: 0},
+		{name: "unknown character", input: "a \u00a7 b", offset: 2},
+		{name: "token class past the end", opts: []sx.TokenizerOption{overlong}, input: "a @b", offset: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sx.NewTokenizer(tt.opts...).Tokenize(tt.input)
+			var syntaxErr *sx.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Tokenize(%q) error = %v, want *SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Offset != tt.offset {
+				t.Errorf("Tokenize(%q) offset = %d, want %d", tt.input, syntaxErr.Offset, tt.offset)
+			}
+		})
+	}
+}
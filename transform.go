@@ -0,0 +1,163 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// Transformer is implemented by the streaming conversions of this package.
+// It is the transform.Transformer interface of golang.org/x/text, so the
+// conversions can be chained with encoding and normalization transformers via
+// transform.Chain and used with transform.NewReader and transform.NewWriter.
+type Transformer = transform.Transformer
+
+// tokenTransformer applies a conversion to each whitespace-delimited token
+type tokenTransformer struct {
+	convert func(string) string
+}
+
+// NewTokenTransformer returns a Transformer that applies convert to each
+// maximal run of non-whitespace runes and copies whitespace unchanged. A
+// token is only converted once it is complete, so the output does not depend
+// on how the input is split into chunks.
+//
+// Example:
+//
+//	t := NewTokenTransformer(strings.ToUpper)
+//	transform.String(t, "a b\nc") // "A B\nC"
+func NewTokenTransformer(convert func(string) string) Transformer {
+	return &tokenTransformer{convert: convert}
+}
+
+// Transform implements transform.Transformer
+func (t *tokenTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if !atEOF && !utf8.FullRune(src[nSrc:]) {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if r, size := utf8.DecodeRune(src[nSrc:]); unicode.IsSpace(r) {
+			if nDst+size > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+			nSrc += size
+			continue
+		}
+
+		end := nSrc + tokenEnd(src[nSrc:])
+		if end == len(src) && !atEOF {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		converted := t.convert(string(src[nSrc:end]))
+		if nDst+len(converted) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], converted)
+		nSrc = end
+	}
+	return nDst, nSrc, nil
+}
+
+// Reset implements transform.Transformer
+func (t *tokenTransformer) Reset() {}
+
+// tokenEnd returns the length of the token at the start of b, which ends at
+// the first whitespace rune
+func tokenEnd(b []byte) int {
+	for i := 0; i < len(b); {
+		r, size := utf8.DecodeRune(b[i:])
+		if unicode.IsSpace(r) {
+			return i
+		}
+		i += size
+	}
+	return len(b)
+}
+
+// CamelCaseTransformer returns a Transformer converting each
+// whitespace-delimited token to camelCase
+func CamelCaseTransformer(opts ...Option) Transformer {
+	return NewTokenTransformer(func(s string) string { return CamelCase(s, opts...) })
+}
+
+// PascalCaseTransformer returns a Transformer converting each
+// whitespace-delimited token to PascalCase
+func PascalCaseTransformer(opts ...Option) Transformer {
+	return NewTokenTransformer(func(s string) string { return PascalCase(s, opts...) })
+}
+
+// KebabCaseTransformer returns a Transformer converting each
+// whitespace-delimited token to kebab-case
+func KebabCaseTransformer(opts ...Option) Transformer {
+	return NewTokenTransformer(func(s string) string { return DelimitedCase(s, "-", opts...) })
+}
+
+// SnakeCaseTransformer returns a Transformer converting each
+// whitespace-delimited token to snake_case
+func SnakeCaseTransformer(opts ...Option) Transformer {
+	return NewTokenTransformer(func(s string) string { return SnakeCase(s, opts...) })
+}
+
+// TrainCaseTransformer returns a Transformer converting each
+// whitespace-delimited token to Train-Case
+func TrainCaseTransformer(opts ...Option) Transformer {
+	return NewTokenTransformer(func(s string) string { return TrainCase(s, opts...) })
+}
+
+// FlatCaseTransformer returns a Transformer converting each
+// whitespace-delimited token to flatcase
+func FlatCaseTransformer(opts ...Option) Transformer {
+	return NewTokenTransformer(func(s string) string { return FlatCase(s, opts...) })
+}
+
+// htmlTransformer streams StripHTML
+type htmlTransformer struct {
+	opts     []HTMLOption
+	stripper *htmlStripper
+}
+
+// StripHTMLTransformer returns a Transformer that converts HTML to plain
+// text like StripHTML. Markup, character references and script or style
+// content may span chunk boundaries; the output is the same as StripHTML
+// applied to the whole input.
+func StripHTMLTransformer(opts ...HTMLOption) Transformer {
+	return &htmlTransformer{opts: opts, stripper: newHTMLStripper(opts)}
+}
+
+// Transform implements transform.Transformer
+func (t *htmlTransformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	n := len(src)
+	for {
+		saved := *t.stripper
+		var result strings.Builder
+		nSrc = t.stripper.strip(&result, string(src[:n]), atEOF && n == len(src))
+		if result.Len() <= len(dst) {
+			nDst = copy(dst, result.String())
+			break
+		}
+		// Re-escaped text may outgrow dst; retry with less input
+		*t.stripper = saved
+		if n <= 1 {
+			return 0, 0, transform.ErrShortDst
+		}
+		n /= 2
+	}
+
+	if nSrc < len(src) {
+		if n < len(src) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		return nDst, nSrc, transform.ErrShortSrc
+	}
+	return nDst, nSrc, nil
+}
+
+// Reset implements transform.Transformer
+func (t *htmlTransformer) Reset() {
+	t.stripper = newHTMLStripper(t.opts)
+}
@@ -0,0 +1,112 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode"
+
+	"github.com/gomantics/sx"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+)
+
+func TestCaseTransformers(t *testing.T) {
+	tests := []struct {
+		name        string
+		transformer sx.Transformer
+		input       string
+		expected    string
+	}{
+		{name: "camel", transformer: sx.CamelCaseTransformer(), input: "user_id first-name\nLastName", expected: "userId firstName\nlastName"},
+		{name: "camel acronyms", transformer: sx.CamelCaseTransformer(sx.WithAcronyms("ID")), input: "user_id", expected: "userID"},
+		{name: "pascal", transformer: sx.PascalCaseTransformer(), input: "  user_id\t", expected: "  UserId\t"},
+		{name: "kebab", transformer: sx.KebabCaseTransformer(), input: "fooBar bazQux", expected: "foo-bar baz-qux"},
+		{name: "kebab options", transformer: sx.KebabCaseTransformer(sx.WithNumberWordStyle(sx.NumberSeparated)), input: "HTML5Parser", expected: "html-5-parser"},
+		{name: "snake", transformer: sx.SnakeCaseTransformer(), input: "fooBar\r\nHTTPServer", expected: "foo_bar\r\nhttp_server"},
+		{name: "train", transformer: sx.TrainCaseTransformer(), input: "foo_bar", expected: "Foo-Bar"},
+		{name: "flat", transformer: sx.FlatCaseTransformer(), input: "foo_bar", expected: "foobar"},
+		{name: "unicode whitespace", transformer: sx.SnakeCaseTransformer(), input: "fooBar\u3000barBaz", expected: "foo_bar\u3000bar_baz"},
+		{name: "empty", transformer: sx.SnakeCaseTransformer(), input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _, err := transform.String(tt.transformer, tt.input)
+			if err != nil {
+				t.Fatalf("transform.String(%q) error = %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("transform.String(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStripHTMLTransformer(t *testing.T) {
+	inputs := []string{
+		"<p>Hello, <b>world</b>!</p>",
+		"<div>a</div><div>b</div>",
+		"Fish &amp; chips &eacute;t&#233; &lt;tag&gt;",
+		"caf\u00e9 \u2014 na\u00efve \U0001F600",
+		"a<script>if (a < b && c > d) {}</script>b",
+		"<style>p { color: red }</style>text<!-- a comment with <b>tags</b> -->more",
+		"<a href=\"x > y\" title='it > was'>link</a>",
+		"1 < 2 and 3 > 2",
+		"unterminated <b",
+		"unclosed <script>alert(1)",
+	}
+
+	for _, input := range inputs {
+		for _, opts := range [][]sx.HTMLOption{nil, {sx.WithAllowedTags("b")}} {
+			expected := sx.StripHTML(input, opts...)
+
+			result, _, err := transform.String(sx.StripHTMLTransformer(opts...), input)
+			if err != nil {
+				t.Fatalf("transform.String(%q) error = %v", input, err)
+			}
+			if result != expected {
+				t.Errorf("transform.String(%q) = %q, want %q", input, result, expected)
+			}
+
+			if result := readOneByte(t, sx.StripHTMLTransformer(opts...), input); result != expected {
+				t.Errorf("byte-by-byte StripHTMLTransformer(%q) = %q, want %q", input, result, expected)
+			}
+		}
+	}
+}
+
+func TestTransformers_Chunked(t *testing.T) {
+	input := strings.Repeat("userAccountId HTTPServer caf\u00e9Cr\u00e8me\n", 200)
+	expected := sx.SnakeCase("userAccountId") + " " + sx.SnakeCase("HTTPServer") + " " + sx.SnakeCase("caf\u00e9Cr\u00e8me") + "\n"
+
+	if result := readOneByte(t, sx.SnakeCaseTransformer(), input); result != strings.Repeat(expected, 200) {
+		t.Errorf("byte-by-byte SnakeCaseTransformer = %q", result[:80])
+	}
+}
+
+func TestTransformers_Chain(t *testing.T) {
+	chain := transform.Chain(sx.StripHTMLTransformer(), sx.KebabCaseTransformer(), runes.Map(unicode.ToUpper))
+
+	result, _, err := transform.String(chain, "<li>fooBar</li><li>bazQux</li>")
+	if err != nil {
+		t.Fatalf("transform.String error = %v", err)
+	}
+	if expected := "FOO-BAR BAZ-QUX"; result != expected {
+		t.Errorf("transform.String = %q, want %q", result, expected)
+	}
+}
+
+// readOneByte transforms input read one byte at a time
+func readOneByte(t *testing.T, transformer sx.Transformer, input string) string {
+	t.Helper()
+	r := transform.NewReader(iotest.OneByteReader(strings.NewReader(input)), transformer)
+	result, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading %q: %v", input, err)
+	}
+	return string(result)
+}
@@ -0,0 +1,93 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Translate replaces every rune of s found in from with the rune at the same
+// position in to, like the Unix tr command. Both sets may contain ranges
+// such as "a-z"; a '-' at the start or end of a set, or one that does not
+// form an ascending range, stands for itself. When to is shorter than from
+// its last rune is repeated, and when to is empty the runes of from are
+// deleted. A rune listed more than once in from takes its last mapping.
+//
+// Example:
+//
+//	Translate("hello world", "a-z", "A-Z") // HELLO WORLD
+//	Translate("2024-01-15", "-", "/")      // 2024/01/15
+//	Translate("a,b;c", ",;", "")           // abc
+func Translate(s, from, to string) string {
+	fromRunes, toRunes := expandRuneSet(from), expandRuneSet(to)
+	if len(fromRunes) == 0 {
+		return s
+	}
+
+	var ascii [utf8.RuneSelf]rune
+	for i := range ascii {
+		ascii[i] = rune(i)
+	}
+	var other map[rune]rune
+	for i, r := range fromRunes {
+		target := rune(-1)
+		if len(toRunes) > 0 {
+			target = toRunes[min(i, len(toRunes)-1)]
+		}
+		if r < utf8.RuneSelf {
+			ascii[r] = target
+			continue
+		}
+		if other == nil {
+			other = make(map[rune]rune)
+		}
+		other[r] = target
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r < utf8.RuneSelf {
+			return ascii[r]
+		}
+		if target, ok := other[r]; ok {
+			return target
+		}
+		return r
+	}, s)
+}
+
+// TranslateMap replaces every rune of s that is a key of mapping with its
+// value; runes mapped to a negative value are deleted. Like Translate, it
+// returns s itself when nothing changes.
+//
+// Example:
+//
+//	TranslateMap("naïve café", map[rune]rune{'ï': 'i', 'é': 'e'}) // naive cafe
+func TranslateMap(s string, mapping map[rune]rune) string {
+	if len(mapping) == 0 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if target, ok := mapping[r]; ok {
+			return target
+		}
+		return r
+	}, s)
+}
+
+// expandRuneSet returns the runes of a Translate set with ranges expanded
+func expandRuneSet(set string) []rune {
+	runes := []rune(set)
+	expanded := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		if i+2 < len(runes) && runes[i+1] == '-' && runes[i] <= runes[i+2] {
+			for r := runes[i]; r <= runes[i+2]; r++ {
+				expanded = append(expanded, r)
+			}
+			i += 2
+			continue
+		}
+		expanded = append(expanded, runes[i])
+	}
+	return expanded
+}
@@ -0,0 +1,62 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		from, to string
+		expected string
+	}{
+		{name: "range", input: "hello world", from: "a-z", to: "A-Z", expected: "HELLO WORLD"},
+		{name: "single rune", input: "2024-01-15", from: "-", to: "/", expected: "2024/01/15"},
+		{name: "delete", input: "a,b;c", from: ",;", to: "", expected: "abc"},
+		{name: "short target repeats last rune", input: "abcd", from: "a-d", to: "xy", expected: "xyyy"},
+		{name: "rot13", input: "Hello", from: "A-Za-z", to: "N-ZA-Mn-za-m", expected: "Uryyb"},
+		{name: "swap", input: "abba", from: "ab", to: "ba", expected: "baab"},
+		{name: "leading dash literal", input: "a-b+c", from: "-+", to: "__", expected: "a_b_c"},
+		{name: "trailing dash literal", input: "a-b", from: "a-", to: "x_", expected: "x_b"},
+		{name: "descending range literal", input: "z-a", from: "z-a", to: "123", expected: "123"},
+		{name: "last mapping wins", input: "a", from: "aa", to: "xy", expected: "y"},
+		{name: "non-ascii", input: "stra\u00dfe", from: "\u00df", to: "s", expected: "strase"},
+		{name: "to non-ascii", input: "a-b", from: "-", to: "\u2013", expected: "a\u2013b"},
+		{name: "empty from", input: "abc", from: "", to: "x", expected: "abc"},
+		{name: "empty input", input: "", from: "a", to: "b", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.Translate(tt.input, tt.from, tt.to); result != tt.expected {
+				t.Errorf("Translate(%q, %q, %q) = %q, want %q", tt.input, tt.from, tt.to, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTranslateMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mapping  map[rune]rune
+		expected string
+	}{
+		{name: "accents", input: "na\u00efve caf\u00e9", mapping: map[rune]rune{'\u00ef': 'i', '\u00e9': 'e'}, expected: "naive cafe"},
+		{name: "delete", input: "a\tb\nc", mapping: map[rune]rune{'\t': -1, '\n': ' '}, expected: "ab c"},
+		{name: "nil map", input: "abc", mapping: nil, expected: "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.TranslateMap(tt.input, tt.mapping); result != tt.expected {
+				t.Errorf("TranslateMap(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
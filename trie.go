@@ -0,0 +1,161 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"cmp"
+	"slices"
+	"unicode"
+)
+
+// foldRune maps r to a canonical case so that runes differing only in case compare equal
+func foldRune(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+// trieNode is a single node of a Trie. Children are kept sorted by rune.
+type trieNode[V any] struct {
+	r        rune
+	children []*trieNode[V]
+	key      string
+	value    V
+	terminal bool
+}
+
+// child returns the child node for r, or nil
+func (n *trieNode[V]) child(r rune) *trieNode[V] {
+	i, found := slices.BinarySearchFunc(n.children, r, func(c *trieNode[V], r rune) int {
+		return cmp.Compare(c.r, r)
+	})
+	if !found {
+		return nil
+	}
+	return n.children[i]
+}
+
+// childOrCreate returns the child node for r, creating it if necessary
+func (n *trieNode[V]) childOrCreate(r rune) *trieNode[V] {
+	i, found := slices.BinarySearchFunc(n.children, r, func(c *trieNode[V], r rune) int {
+		return cmp.Compare(c.r, r)
+	})
+	if found {
+		return n.children[i]
+	}
+
+	c := &trieNode[V]{r: r}
+	n.children = slices.Insert(n.children, i, c)
+	return c
+}
+
+// Trie maps string keys to values and answers prefix queries efficiently.
+// The zero value is not usable; create tries with NewTrie or NewFoldTrie.
+type Trie[V any] struct {
+	root *trieNode[V]
+	fold bool
+	size int
+}
+
+// NewTrie returns an empty case-sensitive Trie
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: &trieNode[V]{}}
+}
+
+// NewFoldTrie returns an empty Trie that matches keys case-insensitively
+func NewFoldTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: &trieNode[V]{}, fold: true}
+}
+
+// normalize applies case folding when the trie is case-insensitive
+func (t *Trie[V]) normalize(r rune) rune {
+	if t.fold {
+		return foldRune(r)
+	}
+	return r
+}
+
+// Insert stores value under key, replacing any existing value
+func (t *Trie[V]) Insert(key string, value V) {
+	n := t.root
+	for _, r := range key {
+		n = n.childOrCreate(t.normalize(r))
+	}
+
+	if !n.terminal {
+		t.size++
+	}
+	n.key = key
+	n.value = value
+	n.terminal = true
+}
+
+// find returns the node reached by walking s, or nil
+func (t *Trie[V]) find(s string) *trieNode[V] {
+	n := t.root
+	for _, r := range s {
+		if n = n.child(t.normalize(r)); n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// Get returns the value stored under key
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.terminal {
+		return n.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Len returns the number of keys in the trie
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// LongestPrefix returns the longest stored key that is a prefix of s,
+// along with its value
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	var best *trieNode[V]
+	n := t.root
+	if n.terminal {
+		best = n
+	}
+
+	for _, r := range s {
+		if n = n.child(t.normalize(r)); n == nil {
+			break
+		}
+		if n.terminal {
+			best = n
+		}
+	}
+
+	if best == nil {
+		var zero V
+		return "", zero, false
+	}
+	return best.key, best.value, true
+}
+
+// WalkPrefix calls fn for every stored key starting with prefix, in rune
+// order. Walking stops early if fn returns false.
+func (t *Trie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	if n := t.find(prefix); n != nil {
+		walkTrie(n, fn)
+	}
+}
+
+// walkTrie visits n and its descendants depth-first, reporting whether to continue
+func walkTrie[V any](n *trieNode[V], fn func(string, V) bool) bool {
+	if n.terminal && !fn(n.key, n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !walkTrie(c, fn) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestTrie(t *testing.T) {
+	tr := sx.NewTrie[int]()
+	tr.Insert("/api", 1)
+	tr.Insert("/api/users", 2)
+	tr.Insert("/api/users/admin", 3)
+	tr.Insert("/static", 4)
+
+	if got := tr.Len(); got != 4 {
+		t.Errorf("Len() = %d, want 4", got)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		key   string
+		value int
+		found bool
+	}{
+		{name: "exact", input: "/api/users", key: "/api/users", value: 2, found: true},
+		{name: "longer path", input: "/api/users/42", key: "/api/users", value: 2, found: true},
+		{name: "deepest", input: "/api/users/admin/x", key: "/api/users/admin", value: 3, found: true},
+		{name: "shortest", input: "/apix", key: "/api", value: 1, found: true},
+		{name: "no match", input: "/other", key: "", value: 0, found: false},
+		{name: "case sensitive", input: "/API/users", key: "", value: 0, found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, value, ok := tr.LongestPrefix(tt.input)
+			if key != tt.key || value != tt.value || ok != tt.found {
+				t.Errorf("LongestPrefix(%q) = %q, %d, %v, want %q, %d, %v", tt.input, key, value, ok, tt.key, tt.value, tt.found)
+			}
+		})
+	}
+
+	if v, ok := tr.Get("/api/users"); !ok || v != 2 {
+		t.Errorf("Get(%q) = %d, %v, want 2, true", "/api/users", v, ok)
+	}
+	if _, ok := tr.Get("/api/user"); ok {
+		t.Errorf("Get(%q) found a value for a non-terminal node", "/api/user")
+	}
+
+	var keys []string
+	tr.WalkPrefix("/api/", func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if !reflect.DeepEqual(keys, []string{"/api/users", "/api/users/admin"}) {
+		t.Errorf("WalkPrefix(%q) = %v", "/api/", keys)
+	}
+
+	keys = nil
+	tr.WalkPrefix("/", func(key string, _ int) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	if len(keys) != 2 {
+		t.Errorf("WalkPrefix did not stop early, visited %v", keys)
+	}
+}
+
+func TestFoldTrie(t *testing.T) {
+	tr := sx.NewFoldTrie[string]()
+	tr.Insert("Checkout", "co")
+	tr.Insert("Commit", "ci")
+
+	if v, ok := tr.Get("COMMIT"); !ok || v != "ci" {
+		t.Errorf("Get(%q) = %q, %v, want %q, true", "COMMIT", v, ok, "ci")
+	}
+
+	key, _, ok := tr.LongestPrefix("checkout-branch")
+	if !ok || key != "Checkout" {
+		t.Errorf("LongestPrefix(%q) = %q, %v, want %q, true", "checkout-branch", key, ok, "Checkout")
+	}
+
+	var keys []string
+	tr.WalkPrefix("c", func(key string, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if !reflect.DeepEqual(keys, []string{"Checkout", "Commit"}) {
+		t.Errorf("WalkPrefix(%q) = %v", "c", keys)
+	}
+}
@@ -0,0 +1,212 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Slug is a URL-safe name: lowercase words of letters and digits joined by
+// single hyphens, like "hello-world-2". Values entering through NewSlug,
+// UnmarshalText or Scan are normalized, and values leaving through
+// MarshalText or Value are validated, so an invalid Slug cannot cross the
+// boundary of a domain model.
+type Slug string
+
+// NewSlug returns s normalized to a Slug: it is split into words at case
+// changes and at every rune that is not a letter or digit, and the words are
+// lowercased and joined with hyphens. The error wraps ErrInvalidSlug when s
+// has no letters or digits.
+//
+// Example:
+//
+//	NewSlug("Hello, World!")  // hello-world
+//	NewSlug("XMLHttpRequest") // xml-http-request
+func NewSlug(s string) (Slug, error) {
+	var words []string
+	for _, word := range slugConfig.split(s) {
+		if word != "" {
+			words = append(words, toLowerString(word))
+		}
+	}
+	slug := Slug(strings.Join(words, "-"))
+	if err := slug.Validate(); err != nil {
+		return "", err
+	}
+	return slug, nil
+}
+
+// slugConfig splits slugs at case changes and at every rune that is not a
+// letter or digit. It is never modified.
+var slugConfig = &Config{Separators: []rune{}, SeparatorFunc: isNotLetterOrDigit}
+
+// isNotLetterOrDigit reports whether r is neither a letter nor a digit
+func isNotLetterOrDigit(r rune) bool {
+	return !isLetterOrDigit(r)
+}
+
+// String returns the slug as a string
+func (s Slug) String() string {
+	return string(s)
+}
+
+// Validate returns an error wrapping ErrInvalidSlug if s is not a valid slug
+func (s Slug) Validate() error {
+	if s == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidSlug)
+	}
+	prev := '-'
+	for i, r := range string(s) {
+		switch {
+		case r == '-' && prev == '-':
+			return fmt.Errorf("%w: misplaced hyphen at offset %d in %q", ErrInvalidSlug, i, string(s))
+		case r != '-' && (!isLetterOrDigit(r) || isUpper(r)):
+			return fmt.Errorf("%w: invalid character %q in %q", ErrInvalidSlug, r, string(s))
+		}
+		prev = r
+	}
+	if prev == '-' {
+		return fmt.Errorf("%w: trailing hyphen in %q", ErrInvalidSlug, string(s))
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, failing for an invalid slug
+func (s Slug) MarshalText() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, normalizing text with
+// NewSlug
+func (s *Slug) UnmarshalText(text []byte) error {
+	slug, err := NewSlug(string(text))
+	if err != nil {
+		return err
+	}
+	*s = slug
+	return nil
+}
+
+// Scan implements sql.Scanner, normalizing the column value with NewSlug.
+// NULL is rejected; scan into a sql.Null[Slug] for nullable columns.
+func (s *Slug) Scan(src any) error {
+	text, err := scanText(src)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSlug, err)
+	}
+	return s.UnmarshalText(text)
+}
+
+// Value implements driver.Valuer, failing for an invalid slug
+func (s Slug) Value() (driver.Value, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return string(s), nil
+}
+
+// Identifier is a programming language identifier: a letter or underscore
+// followed by letters, digits and underscores, like "userID" or "_tmp2".
+// Like Slug, it is normalized on the way in and validated on the way out.
+type Identifier string
+
+// NewIdentifier returns s normalized to an Identifier: every run of
+// characters that cannot appear in an identifier becomes an underscore, or
+// is dropped at either end, and an underscore is prepended when the result
+// starts with a digit. Case is kept. The error wraps ErrInvalidIdentifier
+// when s has no letters, digits or underscores.
+//
+// Example:
+//
+//	NewIdentifier("user-ID")   // user_ID
+//	NewIdentifier("2nd place") // _2nd_place
+func NewIdentifier(s string) (Identifier, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return !isIdentPart(r) })
+	ident := strings.Join(fields, "_")
+	if r, _ := utf8.DecodeRuneInString(ident); isDigit(r) {
+		ident = "_" + ident
+	}
+	if ident == "" {
+		return "", fmt.Errorf("%w: no letters or digits in %q", ErrInvalidIdentifier, s)
+	}
+	return Identifier(ident), nil
+}
+
+// String returns the identifier as a string
+func (id Identifier) String() string {
+	return string(id)
+}
+
+// Validate returns an error wrapping ErrInvalidIdentifier if id is not a
+// valid identifier
+func (id Identifier) Validate() error {
+	if id == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidIdentifier)
+	}
+	for i, r := range string(id) {
+		if i == 0 && !isIdentStart(r) || !isIdentPart(r) {
+			return fmt.Errorf("%w: invalid character %q in %q", ErrInvalidIdentifier, r, string(id))
+		}
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, failing for an invalid
+// identifier
+func (id Identifier) MarshalText() ([]byte, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(id), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, normalizing text with
+// NewIdentifier
+func (id *Identifier) UnmarshalText(text []byte) error {
+	ident, err := NewIdentifier(string(text))
+	if err != nil {
+		return err
+	}
+	*id = ident
+	return nil
+}
+
+// Scan implements sql.Scanner, normalizing the column value with
+// NewIdentifier. NULL is rejected; scan into a sql.Null[Identifier] for
+// nullable columns.
+func (id *Identifier) Scan(src any) error {
+	text, err := scanText(src)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidIdentifier, err)
+	}
+	return id.UnmarshalText(text)
+}
+
+// Value implements driver.Valuer, failing for an invalid identifier
+func (id Identifier) Value() (driver.Value, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	return string(id), nil
+}
+
+// scanText returns the text of a database column value
+func scanText(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	case nil:
+		return nil, errors.New("cannot scan NULL")
+	default:
+		return nil, fmt.Errorf("cannot scan %T", src)
+	}
+}
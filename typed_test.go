@@ -0,0 +1,168 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestNewSlug(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected sx.Slug
+		wantErr  bool
+	}{
+		{input: "Hello, World!", expected: "hello-world"},
+		{input: "XMLHttpRequest", expected: "xml-http-request"},
+		{input: "  already-a-slug  ", expected: "already-a-slug"},
+		{input: "Version 2.0", expected: "version-2-0"},
+		{input: "caf\u00e9 au lait", expected: "caf\u00e9-au-lait"},
+		{input: "--", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := sx.NewSlug(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, sx.ErrInvalidSlug) {
+					t.Errorf("NewSlug(%q) error = %v, want ErrInvalidSlug", tt.input, err)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("NewSlug(%q) = %q, %v, want %q", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSlugValidate(t *testing.T) {
+	tests := []struct {
+		slug  sx.Slug
+		valid bool
+	}{
+		{slug: "hello-world-2", valid: true},
+		{slug: "caf\u00e9", valid: true},
+		{slug: "", valid: false},
+		{slug: "Hello", valid: false},
+		{slug: "hello_world", valid: false},
+		{slug: "-hello", valid: false},
+		{slug: "hello-", valid: false},
+		{slug: "hello--world", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.slug), func(t *testing.T) {
+			err := tt.slug.Validate()
+			if tt.valid != (err == nil) {
+				t.Errorf("Validate(%q) = %v, want valid %v", tt.slug, err, tt.valid)
+			}
+			if err != nil && !errors.Is(err, sx.ErrInvalidSlug) {
+				t.Errorf("Validate(%q) = %v, want ErrInvalidSlug", tt.slug, err)
+			}
+		})
+	}
+}
+
+func TestNewIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected sx.Identifier
+		wantErr  bool
+	}{
+		{input: "userID", expected: "userID"},
+		{input: "user-ID", expected: "user_ID"},
+		{input: "2nd place", expected: "_2nd_place"},
+		{input: " _tmp ", expected: "_tmp"},
+		{input: "a..b", expected: "a_b"},
+		{input: "!?", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := sx.NewIdentifier(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, sx.ErrInvalidIdentifier) {
+					t.Errorf("NewIdentifier(%q) error = %v, want ErrInvalidIdentifier", tt.input, err)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("NewIdentifier(%q) = %q, %v, want %q", tt.input, result, err, tt.expected)
+			}
+			if err := result.Validate(); err != nil {
+				t.Errorf("Validate(%q) = %v", result, err)
+			}
+		})
+	}
+
+	for _, id := range []sx.Identifier{"", "2x", "a-b"} {
+		if err := id.Validate(); !errors.Is(err, sx.ErrInvalidIdentifier) {
+			t.Errorf("Validate(%q) = %v, want ErrInvalidIdentifier", id, err)
+		}
+	}
+}
+
+func TestTypedJSON(t *testing.T) {
+	var v struct {
+		Slug sx.Slug       `json:"slug"`
+		Name sx.Identifier `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(`{"slug":"My Post","name":"user-id"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Slug != "my-post" || v.Name != "user_id" {
+		t.Errorf("Unmarshal = %+v", v)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil || string(out) != `{"slug":"my-post","name":"user_id"}` {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+
+	v.Slug = "Not A Slug"
+	if _, err := json.Marshal(v); !errors.Is(err, sx.ErrInvalidSlug) {
+		t.Errorf("Marshal invalid slug error = %v, want ErrInvalidSlug", err)
+	}
+	if err := json.Unmarshal([]byte(`{"slug":"!!"}`), &v); !errors.Is(err, sx.ErrInvalidSlug) {
+		t.Errorf("Unmarshal invalid slug error = %v, want ErrInvalidSlug", err)
+	}
+}
+
+func TestTypedSQL(t *testing.T) {
+	var slug sx.Slug
+	if err := slug.Scan([]byte("Hello World")); err != nil || slug != "hello-world" {
+		t.Errorf("Scan = %q, %v", slug, err)
+	}
+	if err := slug.Scan(nil); !errors.Is(err, sx.ErrInvalidSlug) {
+		t.Errorf("Scan(nil) error = %v, want ErrInvalidSlug", err)
+	}
+	if err := slug.Scan(42); !errors.Is(err, sx.ErrInvalidSlug) {
+		t.Errorf("Scan(42) error = %v, want ErrInvalidSlug", err)
+	}
+	if value, err := slug.Value(); err != nil || value != "hello-world" {
+		t.Errorf("Value = %v, %v", value, err)
+	}
+	if _, err := sx.Slug("Bad Slug").Value(); !errors.Is(err, sx.ErrInvalidSlug) {
+		t.Errorf("Value of invalid slug error = %v, want ErrInvalidSlug", err)
+	}
+
+	var id sx.Identifier
+	if err := id.Scan("user name"); err != nil || id != "user_name" {
+		t.Errorf("Scan = %q, %v", id, err)
+	}
+	if _, err := sx.Identifier("9lives").Value(); !errors.Is(err, sx.ErrInvalidIdentifier) {
+		t.Errorf("Value of invalid identifier error = %v, want ErrInvalidIdentifier", err)
+	}
+
+	var null sql.Null[sx.Slug]
+	if err := null.Scan(nil); err != nil || null.Valid {
+		t.Errorf("Null Scan(nil) = %+v, %v", null, err)
+	}
+}
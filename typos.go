@@ -0,0 +1,80 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"math/rand/v2"
+	"slices"
+	"unicode"
+)
+
+// typoKinds are the kinds of single-edit misspellings generated by Typos
+const (
+	typoTransposition = iota
+	typoOmission
+	typoDuplication
+	typoNeighborKey
+	typoKindCount
+)
+
+// Typos returns up to n distinct, realistic misspellings of s for fuzzing
+// search and matching code. Each typo is a single edit: two adjacent
+// characters swapped, a character omitted, a character doubled, or a
+// character replaced by a neighboring key on a QWERTY keyboard (keeping its
+// case). Results are deterministic for a seeded r; a nil r uses the global
+// source. Fewer than n typos are returned when s is too short to have n.
+func Typos(s string, n int, r *rand.Rand) []string {
+	runes := []rune(s)
+	if len(runes) == 0 || n <= 0 {
+		return nil
+	}
+
+	intN := rand.IntN
+	if r != nil {
+		intN = r.IntN
+	}
+
+	seen := map[string]bool{s: true}
+	var typos []string
+	for attempts := 0; len(typos) < n && attempts < 20*n+100; attempts++ {
+		typo := makeTypo(runes, intN(typoKindCount), intN(len(runes)), intN)
+		if typo != "" && !seen[typo] {
+			seen[typo] = true
+			typos = append(typos, typo)
+		}
+	}
+	return typos
+}
+
+// makeTypo applies one edit of the given kind at position i, returning "" if
+// the edit is not possible there
+func makeTypo(runes []rune, kind, i int, intN func(int) int) string {
+	switch kind {
+	case typoTransposition:
+		if i+1 >= len(runes) || runes[i] == runes[i+1] {
+			return ""
+		}
+		typo := slices.Clone(runes)
+		typo[i], typo[i+1] = typo[i+1], typo[i]
+		return string(typo)
+	case typoOmission:
+		if len(runes) < 2 {
+			return ""
+		}
+		return string(slices.Delete(slices.Clone(runes), i, i+1))
+	case typoDuplication:
+		return string(slices.Insert(slices.Clone(runes), i, runes[i]))
+	default:
+		neighbors := QWERTY.neighbors(runes[i])
+		if len(neighbors) == 0 {
+			return ""
+		}
+		replacement := neighbors[intN(len(neighbors))]
+		if unicode.IsUpper(runes[i]) {
+			replacement = unicode.ToUpper(replacement)
+		}
+		typo := slices.Clone(runes)
+		typo[i] = replacement
+		return string(typo)
+	}
+}
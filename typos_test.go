@@ -0,0 +1,70 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestTypos(t *testing.T) {
+	input := "keyboard"
+	typos := sx.Typos(input, 10, rand.New(rand.NewPCG(1, 2)))
+	if len(typos) != 10 {
+		t.Fatalf("Typos(%q, 10) returned %d typos: %q", input, len(typos), typos)
+	}
+
+	seen := make(map[string]bool)
+	for _, typo := range typos {
+		if typo == input {
+			t.Errorf("Typos(%q) returned the input itself", input)
+		}
+		if seen[typo] {
+			t.Errorf("Typos(%q) returned duplicate %q", input, typo)
+		}
+		seen[typo] = true
+		// Every typo is a single edit, or a transposition (two substitutions)
+		if d := sx.KeyboardDistance(input, typo, sx.Layout{}); d > 2 {
+			t.Errorf("Typos(%q) returned %q, distance %v", input, typo, d)
+		}
+	}
+
+	again := sx.Typos(input, 10, rand.New(rand.NewPCG(1, 2)))
+	if !slices.Equal(typos, again) {
+		t.Errorf("Typos not deterministic: %q vs %q", typos, again)
+	}
+}
+
+func TestTypos_Limits(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		n       int
+		maxWant int
+	}{
+		{name: "empty", input: "", n: 5, maxWant: 0},
+		{name: "zero", input: "abc", n: 0, maxWant: 0},
+		{name: "single rune without neighbors", input: "日", n: 5, maxWant: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typos := sx.Typos(tt.input, tt.n, nil)
+			if len(typos) > tt.maxWant {
+				t.Errorf("Typos(%q, %d) = %q, want at most %d", tt.input, tt.n, typos, tt.maxWant)
+			}
+		})
+	}
+}
+
+func TestTypos_NeighborKeepsCase(t *testing.T) {
+	for _, typo := range sx.Typos("Q", 20, rand.New(rand.NewPCG(3, 4))) {
+		if strings.ToUpper(typo) != typo {
+			t.Errorf("Typos(%q) returned %q, want neighbors in uppercase", "Q", typo)
+		}
+	}
+}
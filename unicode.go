@@ -0,0 +1,23 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// The character classification and case mapping used by the case converters.
+// Building with the sx_ascii tag replaces them with ASCII-only versions so the
+// Unicode tables are not linked in; see unicode_ascii.go.
+
+func isLetter(r rune) bool { return unicode.IsLetter(r) }
+func isUpper(r rune) bool  { return unicode.IsUpper(r) }
+func isLower(r rune) bool  { return unicode.IsLower(r) }
+func isDigit(r rune) bool  { return unicode.IsDigit(r) }
+func toUpper(r rune) rune  { return unicode.ToUpper(r) }
+func toLower(r rune) rune  { return unicode.ToLower(r) }
+
+func toUpperString(s string) string { return strings.ToUpper(s) }
+func toLowerString(s string) string { return strings.ToLower(s) }
+func equalFold(a, b string) bool    { return strings.EqualFold(a, b) }
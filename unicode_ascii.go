@@ -0,0 +1,66 @@
+//go:build sx_ascii
+
+package sx
+
+// The sx_ascii build tag produces a slim ASCII-only core for size-constrained
+// targets such as TinyGo and WASM plugins. Only the case converters, case
+// detection, protected spans and balanced delimiter matching are compiled;
+// grapheme segmentation, display width, the word list and the other helpers
+// that depend on Unicode tables are left out.
+//
+// In this build only ASCII letters have case and only ASCII digits are
+// digits. Other runes are treated as uncased letters: they stay within words
+// and are never re-cased.
+
+func isLetter(r rune) bool { return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || r >= 0x80 }
+func isUpper(r rune) bool  { return 'A' <= r && r <= 'Z' }
+func isLower(r rune) bool  { return 'a' <= r && r <= 'z' }
+func isDigit(r rune) bool  { return '0' <= r && r <= '9' }
+
+func toUpper(r rune) rune {
+	if isLower(r) {
+		return r - 'a' + 'A'
+	}
+	return r
+}
+
+func toLower(r rune) rune {
+	if isUpper(r) {
+		return r - 'A' + 'a'
+	}
+	return r
+}
+
+func toUpperString(s string) string { return mapASCII(s, toUpper) }
+func toLowerString(s string) string { return mapASCII(s, toLower) }
+
+// mapASCII applies mapping to the ASCII bytes of s, returning s itself when
+// nothing changes
+func mapASCII(s string, mapping func(rune) rune) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if c := byte(mapping(rune(s[i]))); s[i] < 0x80 && c != s[i] {
+			if b == nil {
+				b = []byte(s)
+			}
+			b[i] = c
+		}
+	}
+	if b == nil {
+		return s
+	}
+	return string(b)
+}
+
+// equalFold reports whether a and b are equal under ASCII case folding
+func equalFold(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if toLower(rune(a[i])) != toLower(rune(b[i])) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+//go:build sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestASCIIBuild(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  func(string) string
+		input    string
+		expected string
+	}{
+		{name: "ascii pascal", convert: func(s string) string { return sx.PascalCase(s) }, input: "http_server", expected: "HttpServer"},
+		{name: "ascii kebab", convert: func(s string) string { return sx.KebabCase(s) }, input: "HTTPServer", expected: "http-server"},
+		{name: "non-ascii not recased", convert: func(s string) string { return sx.PascalCase(s) }, input: "\u00e9lan_vital", expected: "\u00e9lanVital"},
+		{name: "non-ascii kept in word", convert: func(s string) string { return sx.SnakeCase(s) }, input: "CAF\u00c9 Bar", expected: "caf\u00c9_bar"},
+		{name: "acronyms fold ascii", convert: func(s string) string { return sx.CamelCase(s, sx.WithAcronyms("ID")) }, input: "user_id", expected: "userID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.convert(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseUUID returns the 32 lowercase hex digits of a UUID written in any
+// common representation: canonical, compact, braced, urn:uuid: prefixed, or
+// uppercase
+func parseUUID(s string) (string, error) {
+	offset := 0
+	if len(s) >= 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s, offset = s[9:], 9
+	} else if len(s) >= 2 && s[0] == '{' && s[len(s)-1] == '}' {
+		s, offset = s[1:len(s)-1], 1
+	}
+
+	var dashed bool
+	switch len(s) {
+	case 32:
+	case 36:
+		dashed = true
+	default:
+		return "", &SyntaxError{Msg: fmt.Sprintf("invalid uuid length %d", len(s)), Offset: offset}
+	}
+
+	hex := make([]byte, 0, 32)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if dashed && (i == 8 || i == 13 || i == 18 || i == 23) {
+			if c != '-' {
+				return "", &SyntaxError{Msg: fmt.Sprintf("expected '-' in uuid, found %q", c), Offset: offset + i}
+			}
+			continue
+		}
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f':
+		case c >= 'A' && c <= 'F':
+			c += 'a' - 'A'
+		default:
+			return "", &SyntaxError{Msg: fmt.Sprintf("invalid uuid character %q", c), Offset: offset + i}
+		}
+		hex = append(hex, c)
+	}
+	return string(hex), nil
+}
+
+// CanonicalUUID returns the UUID in s in canonical lowercase 8-4-4-4-12 form.
+// It accepts the canonical form, 32 hex digits without dashes, braces
+// ({...}), the urn:uuid: prefix and uppercase hex digits. Only the syntax is
+// checked; the version and variant bits are not.
+//
+// Example:
+//
+//	CanonicalUUID("{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}") // "6ba7b810-9dad-11d1-80b4-00c04fd430c8", nil
+func CanonicalUUID(s string) (string, error) {
+	hex, err := parseUUID(s)
+	if err != nil {
+		return "", err
+	}
+	return hex[:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:], nil
+}
+
+// CompactUUID returns the UUID in s as 32 lowercase hex digits without dashes.
+// It accepts the same representations as CanonicalUUID.
+func CompactUUID(s string) (string, error) {
+	return parseUUID(s)
+}
+
+// IsUUID reports whether s is a UUID in any representation accepted by CanonicalUUID
+func IsUUID(s string) bool {
+	_, err := parseUUID(s)
+	return err == nil
+}
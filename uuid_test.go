@@ -0,0 +1,62 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestCanonicalUUID(t *testing.T) {
+	const canonical = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{name: "canonical", input: canonical, expected: canonical},
+		{name: "uppercase", input: "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", expected: canonical},
+		{name: "compact", input: "6ba7b8109dad11d180b400c04fd430c8", expected: canonical},
+		{name: "braced", input: "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}", expected: canonical},
+		{name: "urn", input: "URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8", expected: canonical},
+		{name: "nil uuid", input: "00000000-0000-0000-0000-000000000000", expected: "00000000-0000-0000-0000-000000000000"},
+		{name: "bad length", input: "6ba7b810-9dad-11d1-80b4", expectErr: true},
+		{name: "misplaced dash", input: "6ba7b8109-dad-11d1-80b4-00c04fd430c8", expectErr: true},
+		{name: "bad character", input: "6ba7b810-9dad-11d1-80b4-00c04fd430cg", expectErr: true},
+		{name: "unbalanced brace", input: "{6ba7b810-9dad-11d1-80b4-00c04fd430c8", expectErr: true},
+		{name: "empty", input: "", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sx.CanonicalUUID(tt.input)
+			if tt.expectErr {
+				var syntaxErr *sx.SyntaxError
+				if !errors.As(err, &syntaxErr) {
+					t.Errorf("CanonicalUUID(%q) error = %v, want *SyntaxError", tt.input, err)
+				}
+				if sx.IsUUID(tt.input) {
+					t.Errorf("IsUUID(%q) = true, want false", tt.input)
+				}
+				return
+			}
+			if err != nil || result != tt.expected {
+				t.Errorf("CanonicalUUID(%q) = %q, %v, want %q", tt.input, result, err, tt.expected)
+			}
+			if !sx.IsUUID(tt.input) {
+				t.Errorf("IsUUID(%q) = false, want true", tt.input)
+			}
+		})
+	}
+}
+
+func TestCompactUUID(t *testing.T) {
+	result, err := sx.CompactUUID("{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}")
+	if expected := "6ba7b8109dad11d180b400c04fd430c8"; err != nil || result != expected {
+		t.Errorf("CompactUUID() = %q, %v, want %q", result, err, expected)
+	}
+}
@@ -0,0 +1,72 @@
+//go:build !sx_ascii
+
+package sx
+
+// View wraps a string with a precomputed index of its runes (or grapheme
+// clusters) so that random access and slicing are O(1) after construction
+type View struct {
+	s       string
+	offsets []int
+}
+
+// NewView returns a View indexed by rune
+func NewView(s string) *View {
+	return &View{s: s, offsets: runeOffsets(s)}
+}
+
+// NewGraphemeView returns a View indexed by grapheme cluster, so that
+// combining sequences and emoji are never cut in half
+func NewGraphemeView(s string) *View {
+	return &View{s: s, offsets: graphemeOffsets(s)}
+}
+
+// String returns the underlying string
+func (v *View) String() string {
+	return v.s
+}
+
+// Len returns the number of indexed units (runes or graphemes)
+func (v *View) Len() int {
+	return len(v.offsets) - 1
+}
+
+// At returns the unit at index i, or "" if i is out of range
+func (v *View) At(i int) string {
+	if i < 0 || i >= v.Len() {
+		return ""
+	}
+	return v.s[v.offsets[i]:v.offsets[i+1]]
+}
+
+// Slice returns the units in [start, end). Bounds are clamped to the view.
+func (v *View) Slice(start, end int) string {
+	start = clamp(start, 0, v.Len())
+	end = clamp(end, start, v.Len())
+	return v.s[v.offsets[start]:v.offsets[end]]
+}
+
+// Substr returns up to length units starting at start
+func (v *View) Substr(start, length int) string {
+	if length <= 0 {
+		return ""
+	}
+	start = clamp(start, 0, v.Len())
+	return v.Slice(start, start+min(length, v.Len()-start))
+}
+
+// ByteOffset returns the byte offset of unit i in the underlying string.
+// Index Len() maps to the length of the string.
+func (v *View) ByteOffset(i int) int {
+	return v.offsets[clamp(i, 0, v.Len())]
+}
+
+// clamp limits n to the range [lo, hi]
+func clamp(n, lo, hi int) int {
+	if n < lo {
+		return lo
+	}
+	if n > hi {
+		return hi
+	}
+	return n
+}
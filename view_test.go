@@ -0,0 +1,106 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestView(t *testing.T) {
+	v := sx.NewView("héllo, 世界")
+
+	if got := v.Len(); got != 9 {
+		t.Errorf("Len() = %d, want 9", got)
+	}
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{name: "At ascii", got: v.At(0), expected: "h"},
+		{name: "At multibyte", got: v.At(1), expected: "é"},
+		{name: "At cjk", got: v.At(7), expected: "世"},
+		{name: "At out of range", got: v.At(9), expected: ""},
+		{name: "At negative", got: v.At(-1), expected: ""},
+		{name: "Slice", got: v.Slice(1, 5), expected: "éllo"},
+		{name: "Slice clamped", got: v.Slice(7, 100), expected: "世界"},
+		{name: "Slice inverted", got: v.Slice(5, 2), expected: ""},
+		{name: "Substr", got: v.Substr(7, 1), expected: "世"},
+		{name: "Substr past end", got: v.Substr(5, 10), expected: ", 世界"},
+		{name: "Substr zero length", got: v.Substr(2, 0), expected: ""},
+		{name: "String", got: v.String(), expected: "héllo, 世界"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+
+	if got := v.ByteOffset(7); got != 8 {
+		t.Errorf("ByteOffset(7) = %d, want 8", got)
+	}
+}
+
+func TestGraphemeView(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		length   int
+		at       int
+		expected string
+	}{
+		{
+			name:     "combining accent",
+			input:    "cafe\u0301!",
+			length:   5,
+			at:       3,
+			expected: "e\u0301",
+		},
+		{
+			name:     "zwj family emoji",
+			input:    "a👨‍👩‍👧b",
+			length:   3,
+			at:       1,
+			expected: "👨‍👩‍👧",
+		},
+		{
+			name:     "flags",
+			input:    "🇩🇪🇫🇷",
+			length:   2,
+			at:       1,
+			expected: "🇫🇷",
+		},
+		{
+			name:     "skin tone modifier",
+			input:    "👍🏽ok",
+			length:   3,
+			at:       0,
+			expected: "👍🏽",
+		},
+		{
+			name:     "crlf",
+			input:    "a\r\nb",
+			length:   3,
+			at:       1,
+			expected: "\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := sx.NewGraphemeView(tt.input)
+			if got := v.Len(); got != tt.length {
+				t.Errorf("Len() = %d, want %d", got, tt.length)
+			}
+			if got := v.At(tt.at); got != tt.expected {
+				t.Errorf("At(%d) = %q, want %q", tt.at, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// invisibleNames are the abbreviations Visible uses for common invisible
+// and easily confused runes
+var invisibleNames = map[rune]string{
+	'\r':     "CR",
+	'\u00a0': "NBSP",
+	'\u00ad': "SHY",
+	'\u034f': "CGJ",
+	'\u061c': "ALM",
+	'\u180e': "MVS",
+	'\u200b': "ZWSP",
+	'\u200c': "ZWNJ",
+	'\u200d': "ZWJ",
+	'\u200e': "LRM",
+	'\u200f': "RLM",
+	'\u2028': "LSEP",
+	'\u2029': "PSEP",
+	'\u202a': "LRE",
+	'\u202b': "RLE",
+	'\u202c': "PDF",
+	'\u202d': "LRO",
+	'\u202e': "RLO",
+	'\u202f': "NNBSP",
+	'\u2060': "WJ",
+	'\u2066': "LRI",
+	'\u2067': "RLI",
+	'\u2068': "FSI",
+	'\u2069': "PDI",
+	'\u3000': "IDSP",
+	'\ufeff': "BOM",
+}
+
+// Visible renders the invisible parts of s explicitly, for debugging strings
+// that look identical but differ. Tabs become '→' and spaces at the end of a
+// line '·'; common invisible runes such as zero-width spaces, bidi controls
+// and non-breaking spaces are shown by name ("<ZWSP>"), other control,
+// format and space runes by code point ("<U+2003>") and invalid UTF-8 by
+// byte ("<0xFF>"). Line feeds are kept so the layout survives.
+//
+// Example:
+//
+//	Visible("a\u200bb\tc  ") // a<ZWSP>b→c··
+func Visible(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&b, "<0x%02X>", s[i])
+		case r == '\t':
+			b.WriteRune('→')
+		case r == ' ':
+			// Handle the whole run of spaces at once, so each is looked at once
+			rest := strings.TrimLeft(s[i:], " ")
+			size = len(s) - i - len(rest)
+			mark := " "
+			if rest == "" || rest[0] == '\n' || rest[0] == '\r' {
+				mark = "·"
+			}
+			b.WriteString(strings.Repeat(mark, size))
+		case r == '\n':
+			b.WriteByte('\n')
+		case invisibleNames[r] != "":
+			b.WriteString("<" + invisibleNames[r] + ">")
+		case unicode.IsControl(r), unicode.Is(unicode.Cf, r), unicode.Is(unicode.Zs, r):
+			fmt.Fprintf(&b, "<U+%04X>", r)
+		default:
+			b.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	return b.String()
+}
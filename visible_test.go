@@ -0,0 +1,43 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestVisible(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "plain", input: "hello world", expected: "hello world"},
+		{name: "zero-width space", input: "a\u200bb", expected: "a<ZWSP>b"},
+		{name: "tab", input: "a\tb", expected: "a\u2192b"},
+		{name: "trailing spaces", input: "a  ", expected: "a\u00b7\u00b7"},
+		{name: "trailing spaces per line", input: "a \nb  \r\nc", expected: "a\u00b7\nb\u00b7\u00b7<CR>\nc"},
+		{name: "inner spaces kept", input: "a  b", expected: "a  b"},
+		{name: "non-breaking space", input: "1\u00a0000", expected: "1<NBSP>000"},
+		{name: "bidi override", input: "\u202eabc\u202c", expected: "<RLO>abc<PDF>"},
+		{name: "byte order mark", input: "\ufeffid", expected: "<BOM>id"},
+		{name: "other space", input: "a\u2003b", expected: "a<U+2003>b"},
+		{name: "control", input: "a\x07b", expected: "a<U+0007>b"},
+		{name: "invalid utf-8", input: "a\xffb", expected: "a<0xFF>b"},
+		{name: "combining mark kept", input: "e\u0301", expected: "e\u0301"},
+		{name: "empty", input: "", expected: ""},
+		{name: "long inner run", input: strings.Repeat(" ", 1<<17) + "x", expected: strings.Repeat(" ", 1<<17) + "x"},
+		{name: "long trailing run", input: "x" + strings.Repeat(" ", 1<<17), expected: "x" + strings.Repeat("\u00b7", 1<<17)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.Visible(tt.input); result != tt.expected {
+				t.Errorf("Visible(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
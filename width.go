@@ -0,0 +1,268 @@
+//go:build !sx_ascii
+
+package sx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// wideRanges lists the East Asian Wide and Fullwidth blocks, plus the
+// emoji blocks that terminals render two cells wide
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo initials
+	{0x231A, 0x231B},   // watch, hourglass
+	{0x2329, 0x232A},   // angle brackets
+	{0x23E9, 0x23EC},   // media controls
+	{0x23F0, 0x23F0},   // alarm clock
+	{0x23F3, 0x23F3},   // hourglass
+	{0x25FD, 0x25FE},   // medium small squares
+	{0x2614, 0x2615},   // umbrella, hot beverage
+	{0x2648, 0x2653},   // zodiac
+	{0x267F, 0x267F},   // wheelchair
+	{0x2693, 0x2693},   // anchor
+	{0x26A1, 0x26A1},   // high voltage
+	{0x26AA, 0x26AB},   // circles
+	{0x26BD, 0x26BE},   // balls
+	{0x26C4, 0x26C5},   // snowman, sun
+	{0x26CE, 0x26CE},   // ophiuchus
+	{0x26D4, 0x26D4},   // no entry
+	{0x26EA, 0x26EA},   // church
+	{0x26F2, 0x26F3},   // fountain, golf
+	{0x26F5, 0x26F5},   // sailboat
+	{0x26FA, 0x26FA},   // tent
+	{0x26FD, 0x26FD},   // fuel pump
+	{0x2705, 0x2705},   // check mark
+	{0x270A, 0x270B},   // fists
+	{0x2728, 0x2728},   // sparkles
+	{0x274C, 0x274C},   // cross mark
+	{0x274E, 0x274E},   // cross mark button
+	{0x2753, 0x2755},   // question marks
+	{0x2757, 0x2757},   // exclamation mark
+	{0x2795, 0x2797},   // math symbols
+	{0x27B0, 0x27B0},   // curly loop
+	{0x27BF, 0x27BF},   // double curly loop
+	{0x2B1B, 0x2B1C},   // large squares
+	{0x2B50, 0x2B50},   // star
+	{0x2B55, 0x2B55},   // circle
+	{0x2E80, 0x303E},   // CJK radicals, symbols and punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, CJK compatibility
+	{0x3400, 0x4DBF},   // CJK extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xA960, 0xA97F},   // Hangul Jamo extended A
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE10, 0xFE19},   // vertical forms
+	{0xFE30, 0xFE6F},   // CJK compatibility forms, small forms
+	{0xFF00, 0xFF60},   // fullwidth forms
+	{0xFFE0, 0xFFE6},   // fullwidth signs
+	{0x16FE0, 0x16FE4}, // ideographic symbols
+	{0x17000, 0x18AFF}, // Tangut
+	{0x1B000, 0x1B2FF}, // Kana supplement and extensions
+	{0x1F004, 0x1F004}, // mahjong tile
+	{0x1F0CF, 0x1F0CF}, // playing card
+	{0x1F18E, 0x1F18E}, // AB button
+	{0x1F191, 0x1F19A}, // squared words
+	{0x1F200, 0x1F251}, // enclosed ideographic supplement
+	{0x1F300, 0x1F64F}, // pictographs, emoticons
+	{0x1F680, 0x1F6FF}, // transport and map symbols
+	{0x1F7E0, 0x1F7EB}, // colored circles and squares
+	{0x1F90C, 0x1F9FF}, // supplemental symbols and pictographs
+	{0x1FA70, 0x1FAFF}, // symbols and pictographs extended A
+	{0x20000, 0x2FFFD}, // CJK extension B and beyond
+	{0x30000, 0x3FFFD}, // CJK extension G and beyond
+}
+
+// isWide reports whether r occupies two terminal cells
+func isWide(r rune) bool {
+	if r < wideRanges[0][0] {
+		return false
+	}
+
+	lo, hi := 0, len(wideRanges)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		switch {
+		case r < wideRanges[mid][0]:
+			hi = mid
+		case r > wideRanges[mid][1]:
+			lo = mid + 1
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+// runeWidth returns the number of terminal cells r occupies: 0 for control
+// and combining characters, 2 for wide characters and 1 otherwise
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20, r >= 0x7F && r < 0xA0:
+		return 0
+	case r < 0x300:
+		return 1
+	case isGraphemeExtend(r), r == '\u200B', r == '\u2060', r == '\uFEFF':
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// graphemeWidth returns the number of terminal cells a grapheme cluster occupies
+func graphemeWidth(g string) int {
+	r, size := utf8.DecodeRuneInString(g)
+	if isRegionalIndicator(r) || strings.ContainsRune(g[size:], '\uFE0F') {
+		return 2
+	}
+	return runeWidth(r)
+}
+
+// DisplayWidth returns the number of monospace terminal cells s occupies,
+// counting East Asian wide characters and emoji as two cells and combining
+// marks as zero
+func DisplayWidth(s string) int {
+	return displayWidth(s, false)
+}
+
+// displayWidth returns the number of terminal cells s occupies. With ansi
+// set, ANSI escape sequences take no cells.
+func displayWidth(s string, ansi bool) int {
+	width := 0
+	for i := 0; i < len(s); {
+		if ansi {
+			if n := ansiSequenceLen(s[i:]); n > 0 {
+				i += n
+				continue
+			}
+		}
+		if s[i] < utf8.RuneSelf {
+			if s[i] >= 0x20 && s[i] != 0x7F {
+				width++
+			}
+			i++
+			continue
+		}
+		n := nextGrapheme(s[i:])
+		width += graphemeWidth(s[i : i+n])
+		i += n
+	}
+	return width
+}
+
+// truncateWidth shortens s to at most width cells, appending tail when
+// anything was removed. Grapheme clusters are never split, and with ansi set
+// ANSI escape sequences take no cells and are all kept. A width of zero or
+// less gives "".
+func truncateWidth(s string, width int, tail string, ansi bool) string {
+	if width <= 0 {
+		return ""
+	}
+	if displayWidth(s, ansi) <= width {
+		return s
+	}
+
+	budget := width - displayWidth(tail, ansi)
+	if budget < 0 {
+		// The tail alone is too wide, so it is what gets truncated
+		return truncateCells(tail, width, "", ansi)
+	}
+	return truncateCells(s, budget, tail, ansi)
+}
+
+// truncateCells keeps the grapheme clusters of s that fit in budget cells,
+// writing tail where the cut is made. With ansi set, the ANSI escape
+// sequences of s are all kept.
+func truncateCells(s string, budget int, tail string, ansi bool) string {
+	var b strings.Builder
+	b.Grow(len(s) + len(tail))
+	used, cut := 0, false
+	for i := 0; i < len(s); {
+		if ansi {
+			if n := ansiSequenceLen(s[i:]); n > 0 {
+				b.WriteString(s[i : i+n])
+				i += n
+				continue
+			}
+		} else if cut {
+			break
+		}
+		n := nextGrapheme(s[i:])
+		if !cut {
+			if w := graphemeWidth(s[i : i+n]); used+w <= budget {
+				used += w
+				b.WriteString(s[i : i+n])
+			} else {
+				cut = true
+				b.WriteString(tail)
+			}
+		}
+		i += n
+	}
+	return b.String()
+}
+
+// padWidth pads s with spaces to width cells using the given alignment
+func padWidth(s string, width int, align Align) string {
+	gap := width - DisplayWidth(s)
+	if gap <= 0 {
+		return s
+	}
+
+	switch align {
+	case AlignRight:
+		return strings.Repeat(" ", gap) + s
+	case AlignCenter:
+		left := gap / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", gap-left)
+	default:
+		return s + strings.Repeat(" ", gap)
+	}
+}
+
+// TruncateDisplay shortens s to at most width terminal cells, appending
+// ellipsis when anything was removed. It is meant for colored terminal
+// output: ANSI escape sequences take no cells and are all kept, so styles
+// opened before the cut are still reset, East Asian wide characters and
+// emoji count as two cells, and grapheme clusters are never split.
+//
+// Example:
+//
+//	TruncateDisplay("\x1b[31m日本語テキスト\x1b[0m", 7, "…") // "\x1b[31m日本語…\x1b[0m"
+func TruncateDisplay(s string, width int, ellipsis string) string {
+	return truncateWidth(s, width, ellipsis, true)
+}
+
+// ansiSequenceLen returns the byte length of the ANSI escape sequence at the
+// start of s, or 0 if s does not start with one. It recognizes CSI sequences
+// such as colors and cursor movement, OSC sequences such as hyperlinks, and
+// two-byte escapes; an unterminated sequence extends to the end of s.
+func ansiSequenceLen(s string) int {
+	if len(s) < 2 || s[0] != 0x1B {
+		return 0
+	}
+
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7E {
+				return i + 1
+			}
+		}
+	case ']':
+		for i := 2; i < len(s); i++ {
+			if s[i] == 0x07 {
+				return i + 1
+			}
+			if s[i] == 0x1B && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+	default:
+		return 2
+	}
+	return len(s)
+}
@@ -0,0 +1,68 @@
+//go:build !sx_ascii
+
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{input: "", expected: 0},
+		{input: "hello", expected: 5},
+		{input: "日本語", expected: 6},
+		{input: "café", expected: 4},
+		{input: "👍", expected: 2},
+		{input: "👨‍👩‍👧", expected: 2},
+		{input: "🇯🇵", expected: 2},
+		{input: "a\tb", expected: 2},
+		{input: "ｈｉ", expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := sx.DisplayWidth(tt.input); got != tt.expected {
+				t.Errorf("DisplayWidth(%q) = %d, want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTruncateDisplay(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		ellipsis string
+		expected string
+	}{
+		{name: "fits", input: "hello", width: 5, ellipsis: "…", expected: "hello"},
+		{name: "plain", input: "hello world", width: 6, ellipsis: "…", expected: "hello…"},
+		{name: "wide", input: "日本語テキスト", width: 7, ellipsis: "…", expected: "日本語…"},
+		{name: "wide never split", input: "日本語テキスト", width: 6, ellipsis: "…", expected: "日本…"},
+		{name: "colors fit", input: "\x1b[1;31mhello\x1b[0m", width: 5, ellipsis: "…", expected: "\x1b[1;31mhello\x1b[0m"},
+		{name: "colors kept", input: "\x1b[31mhello\x1b[0m \x1b[32mworld\x1b[0m", width: 7, ellipsis: "...", expected: "\x1b[31mhell...\x1b[0m\x1b[32m\x1b[0m"},
+		{name: "hyperlink", input: "\x1b]8;;https://example.com\x07link text\x1b]8;;\x07", width: 5, ellipsis: "…", expected: "\x1b]8;;https://example.com\x07link…\x1b]8;;\x07"},
+		{name: "grapheme", input: "cafés and more", width: 5, ellipsis: "…", expected: "café…"},
+		{name: "emoji sequence", input: "👨‍👩‍👧 family", width: 3, ellipsis: "…", expected: "👨‍👩‍👧…"},
+		{name: "long ellipsis", input: "abcdef", width: 4, ellipsis: "⋯⋯", expected: "ab⋯⋯"},
+		{name: "ellipsis too wide", input: "abcdef", width: 2, ellipsis: "...", expected: ".."},
+		{name: "zero width", input: "abc", width: 0, ellipsis: "…", expected: ""},
+		{name: "zero width no ellipsis", input: "abc", width: 0, ellipsis: "", expected: ""},
+		{name: "negative width", input: "abc", width: -1, ellipsis: "", expected: ""},
+		{name: "negative width empty input", input: "", width: -3, ellipsis: "…", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sx.TruncateDisplay(tt.input, tt.width, tt.ellipsis); got != tt.expected {
+				t.Errorf("TruncateDisplay(%q, %d, %q) = %q, want %q", tt.input, tt.width, tt.ellipsis, got, tt.expected)
+			}
+		})
+	}
+}
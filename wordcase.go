@@ -0,0 +1,73 @@
+package sx
+
+import "strings"
+
+// UpperWords uppercases the words of s at the given indices, leaving the
+// rest of s as it is. Words are those found by SplitByCase, empty ones not
+// counted; negative indices count from the end, so -1 is the last word.
+// Indices out of range are ignored.
+//
+// Example:
+//
+//	UpperWords("userIdToken", -1)   // userIdTOKEN
+//	UpperWords("user_id_token", 1) // user_ID_token
+func UpperWords(s string, indices ...int) string {
+	return mapWords(s, indices, toUpperString)
+}
+
+// LowerWords lowercases the words of s at the given indices, leaving the
+// rest of s as it is, see UpperWords
+func LowerWords(s string, indices ...int) string {
+	return mapWords(s, indices, toLowerString)
+}
+
+// UpperWord uppercases the i-th word of s, see UpperWords
+func UpperWord(s string, i int) string {
+	return UpperWords(s, i)
+}
+
+// LowerWord lowercases the i-th word of s, see UpperWords
+//
+// Example:
+//
+//	LowerWord("HTTPServer", 0) // httpServer
+func LowerWord(s string, i int) string {
+	return LowerWords(s, i)
+}
+
+// mapWords applies mapping to the words of s at the given indices
+func mapWords(s string, indices []int, mapping func(string) string) string {
+	var words []string
+	for _, word := range SplitByCase(s, WithInvalidUTF8(UTF8Preserve)) {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	selected := make([]bool, len(words))
+	for _, i := range indices {
+		if i < 0 {
+			i += len(words)
+		}
+		if i >= 0 && i < len(words) {
+			selected[i] = true
+		}
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	pos := 0
+	for i, word := range words {
+		// Words are found in order, with only separators between them
+		start := pos + strings.Index(s[pos:], word)
+		b.WriteString(s[pos:start])
+		if selected[i] {
+			b.WriteString(mapping(word))
+		} else {
+			b.WriteString(word)
+		}
+		pos = start + len(word)
+	}
+	b.WriteString(s[pos:])
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestUpperWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		indices  []int
+		expected string
+	}{
+		{name: "last word", input: "userIdToken", indices: []int{-1}, expected: "userIdTOKEN"},
+		{name: "middle word", input: "user_id_token", indices: []int{1}, expected: "user_ID_token"},
+		{name: "several words", input: "user-id-token", indices: []int{0, 2}, expected: "USER-id-TOKEN"},
+		{name: "separators kept", input: "__user__id__", indices: []int{-1}, expected: "__user__ID__"},
+		{name: "spaces kept", input: " get  url ", indices: []int{1}, expected: " get  URL "},
+		{name: "out of range", input: "userId", indices: []int{2, -3}, expected: "userId"},
+		{name: "no indices", input: "userId", expected: "userId"},
+		{name: "invalid utf-8 kept", input: "a\xffb_c", indices: []int{1}, expected: "a\xffb_C"},
+		{name: "empty", input: "", indices: []int{0}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.UpperWords(tt.input, tt.indices...); result != tt.expected {
+				t.Errorf("UpperWords(%q, %v) = %q, want %q", tt.input, tt.indices, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLowerWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		indices  []int
+		expected string
+	}{
+		{name: "first word", input: "HTTPServer", indices: []int{0}, expected: "httpServer"},
+		{name: "last word", input: "USER_ID", indices: []int{-1}, expected: "USER_id"},
+		{name: "all words", input: "Foo.Bar", indices: []int{0, 1}, expected: "foo.bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := sx.LowerWords(tt.input, tt.indices...); result != tt.expected {
+				t.Errorf("LowerWords(%q, %v) = %q, want %q", tt.input, tt.indices, result, tt.expected)
+			}
+		})
+	}
+
+	if result := sx.LowerWord("HTTPServer", 0); result != "httpServer" {
+		t.Errorf("LowerWord = %q, want %q", result, "httpServer")
+	}
+	if result := sx.UpperWord("userIdToken", 1); result != "userIDToken" {
+		t.Errorf("UpperWord = %q, want %q", result, "userIDToken")
+	}
+}
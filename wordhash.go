@@ -0,0 +1,44 @@
+package sx
+
+import "unicode/utf8"
+
+// FNV-1a parameters for 64-bit hashes
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// WordHash hashes the words of s, as split by SplitByCase, in lower case, so
+// identifiers that differ only in case style hash the same. It allocates
+// nothing, which makes it suitable for separator-insensitive map keys and
+// deduplication. Word boundaries are part of the hash: "userid" and
+// "user_id" differ.
+//
+// The hash is FNV-1a, so it is stable across processes and releases.
+//
+// Example:
+//
+//	WordHash("UserID") == WordHash("user-id") // true
+func WordHash(s string) uint64 {
+	h := uint64(fnvOffset64)
+	w := wordScanner{s: s, config: emptyConfig}
+	first := true
+	for word, ok := w.next(); ok; word, ok = w.next() {
+		if word == "" {
+			continue
+		}
+		if !first {
+			// 0xFF never occurs in UTF-8, so it cannot be confused with a rune
+			h = (h ^ 0xFF) * fnvPrime64
+		}
+		first = false
+
+		var buf [utf8.UTFMax]byte
+		for _, r := range word {
+			for _, c := range utf8.AppendRune(buf[:0], toLower(r)) {
+				h = (h ^ uint64(c)) * fnvPrime64
+			}
+		}
+	}
+	return h
+}
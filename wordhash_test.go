@@ -0,0 +1,41 @@
+package sx_test
+
+import (
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWordHash(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{a: "UserID", b: "user_id", equal: true},
+		{a: "UserID", b: "user-id", equal: true},
+		{a: "userId", b: "USER ID", equal: true},
+		{a: "  user__id  ", b: "user.id", equal: true},
+		{a: "", b: "__", equal: true},
+		{a: "UserID", b: "userid", equal: false},
+		{a: "user_id", b: "user_ids", equal: false},
+		{a: "ab_c", b: "a_bc", equal: false},
+		{a: "user\xffid", b: "user\ufffdid", equal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if equal := sx.WordHash(tt.a) == sx.WordHash(tt.b); equal != tt.equal {
+				t.Errorf("WordHash(%q) == WordHash(%q) is %v, want %v", tt.a, tt.b, equal, tt.equal)
+			}
+		})
+	}
+}
+
+func TestWordHashAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		sx.WordHash("XMLHttpRequest_user-id")
+	})
+	if allocs != 0 {
+		t.Errorf("WordHash() allocates %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,81 @@
+package sx
+
+// Words is a string split into words, as returned by SplitByCase. Its
+// methods convert the words to any case style, so an input rendered in
+// several styles is split only once.
+//
+// Example:
+//
+//	words := SplitByCase("userAccountID")
+//	words.ToSnake()  // user_account_id
+//	words.ToPascal() // UserAccountID
+type Words []string
+
+// ToPascal joins the words in PascalCase, see PascalCase
+func (w Words) ToPascal(opts ...Option) string {
+	return PascalCase([]string(w), opts...)
+}
+
+// ToCamel joins the words in camelCase, see CamelCase
+func (w Words) ToCamel(opts ...Option) string {
+	return CamelCase([]string(w), opts...)
+}
+
+// ToKebab joins the words in kebab-case, see KebabCase
+func (w Words) ToKebab(opts ...Option) string {
+	return DelimitedCase([]string(w), "-", opts...)
+}
+
+// ToSnake joins the words in snake_case, see SnakeCase
+func (w Words) ToSnake(opts ...Option) string {
+	return SnakeCase([]string(w), opts...)
+}
+
+// ToSpace joins the lowercase words with spaces, see SpaceCase
+func (w Words) ToSpace(opts ...Option) string {
+	return SpaceCase([]string(w), opts...)
+}
+
+// ToTrain joins the words in Train-Case, see TrainCase
+func (w Words) ToTrain(opts ...Option) string {
+	return TrainCase([]string(w), opts...)
+}
+
+// ToFlat joins the words in flatcase, see FlatCase
+func (w Words) ToFlat(opts ...Option) string {
+	return FlatCase([]string(w), opts...)
+}
+
+// ToDelimited joins the lowercase words with separator, see DelimitedCase
+func (w Words) ToDelimited(separator string, opts ...Option) string {
+	return DelimitedCase([]string(w), separator, opts...)
+}
+
+// Filter returns the words for which keep returns true. w is not modified.
+//
+// Example:
+//
+//	SplitByCase("getUserByID").Filter(func(w string) bool { return w != "By" }) // [get User ID]
+func (w Words) Filter(keep func(word string) bool) Words {
+	result := make(Words, 0, len(w))
+	for _, word := range w {
+		if keep(word) {
+			result = append(result, word)
+		}
+	}
+	return result
+}
+
+// Map returns the words with fn applied to each of them. w is not modified.
+//
+// Example:
+//
+//	// expand maps abbreviations like "src" and "dir" to full words
+//	SplitByCase("srcDir").Map(expand).ToPascal() // SourceDirectory
+func (w Words) Map(fn func(word string) string) Words {
+	result := make(Words, len(w))
+	for i, word := range w {
+		result[i] = fn(word)
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package sx_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gomantics/sx"
+)
+
+func TestWords(t *testing.T) {
+	words := sx.SplitByCase("userAccountID")
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{name: "pascal", result: words.ToPascal(), expected: "UserAccountID"},
+		{name: "camel", result: words.ToCamel(), expected: "userAccountID"},
+		{name: "kebab", result: words.ToKebab(), expected: "user-account-id"},
+		{name: "snake", result: words.ToSnake(), expected: "user_account_id"},
+		{name: "train", result: words.ToTrain(), expected: "User-Account-ID"},
+		{name: "flat", result: words.ToFlat(), expected: "useraccountid"},
+		{name: "space", result: words.ToSpace(), expected: "user account id"},
+		{name: "delimited", result: words.ToDelimited("."), expected: "user.account.id"},
+		{name: "options", result: words.ToPascal(sx.WithNormalize(true)), expected: "UserAccountId"},
+		{name: "filter", result: words.Filter(func(w string) bool { return w != "Account" }).ToSnake(), expected: "user_id"},
+		{name: "map", result: words.Map(strings.ToUpper).ToCamel(), expected: "uSERACCOUNTID"},
+		{name: "converter argument", result: sx.SnakeCase(words), expected: "user_account_id"},
+		{name: "protected word", result: sx.SplitByCase("grpc_client", sx.WithProtectedWords("gRPC")).ToPascal(sx.WithProtectedWords("gRPC")), expected: "gRPCClient"},
+		{name: "protected span", result: sx.SplitByCase("get{userId}", braces).ToSnake(braces), expected: "get_{userId}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+
+	if !slices.Equal(words, sx.Words{"user", "Account", "ID"}) {
+		t.Errorf("words modified: %q", words)
+	}
+}
+
+var braces = sx.WithProtectedDelimiters(sx.Pair{Open: "{", Close: "}"})
+
+func TestWordsMatchConverters(t *testing.T) {
+	inputs := []string{"", "fooBar", "HTTPServer", "foo__bar", "-leading", "trailing_", "XMLHttpRequest2", "a.b/c d\\e"}
+
+	for _, input := range inputs {
+		words := sx.SplitByCase(input)
+		pairs := [][2]string{
+			{words.ToPascal(), sx.PascalCase(input)},
+			{words.ToCamel(), sx.CamelCase(input)},
+			{words.ToKebab(), sx.KebabCase(input)},
+			{words.ToSnake(), sx.SnakeCase(input)},
+			{words.ToTrain(), sx.TrainCase(input)},
+			{words.ToFlat(), sx.FlatCase(input)},
+		}
+		for _, pair := range pairs {
+			if pair[0] != pair[1] {
+				t.Errorf("%q: Words gave %q, converter gave %q", input, pair[0], pair[1])
+			}
+		}
+	}
+}